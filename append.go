@@ -0,0 +1,269 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import "math"
+
+var (
+	_ AppendByteOrder = LittleEndian(nil)
+	_ AppendByteOrder = BigEndian(nil)
+)
+
+// An AppendByteOrder describes a behavior that appends integers and floats to a byte slice. It is like
+// encoding/binary.AppendByteOrder but also covers the 24, 40, 48 and 56 bit widths, signed integers and floats.
+type AppendByteOrder interface {
+
+	// AppendUint16 appends 2 bytes to dst and returns the extended slice.
+	AppendUint16(dst []byte, v uint16) []byte
+
+	// AppendUint24 appends 3 bytes to dst and returns the extended slice.
+	AppendUint24(dst []byte, v uint32) []byte
+
+	// AppendUint32 appends 4 bytes to dst and returns the extended slice.
+	AppendUint32(dst []byte, v uint32) []byte
+
+	// AppendUint40 appends 5 bytes to dst and returns the extended slice.
+	AppendUint40(dst []byte, v uint64) []byte
+
+	// AppendUint48 appends 6 bytes to dst and returns the extended slice.
+	AppendUint48(dst []byte, v uint64) []byte
+
+	// AppendUint56 appends 7 bytes to dst and returns the extended slice.
+	AppendUint56(dst []byte, v uint64) []byte
+
+	// AppendUint64 appends 8 bytes to dst and returns the extended slice.
+	AppendUint64(dst []byte, v uint64) []byte
+
+	// AppendInt16 appends 2 bytes to dst and returns the extended slice.
+	AppendInt16(dst []byte, v int16) []byte
+
+	// AppendInt24 appends 3 bytes to dst and returns the extended slice. Panics if v is not within
+	// [MinInt24, MaxInt24].
+	AppendInt24(dst []byte, v int32) []byte
+
+	// AppendInt32 appends 4 bytes to dst and returns the extended slice.
+	AppendInt32(dst []byte, v int32) []byte
+
+	// AppendInt40 appends 5 bytes to dst and returns the extended slice. Panics if v is not within
+	// [MinInt40, MaxInt40].
+	AppendInt40(dst []byte, v int64) []byte
+
+	// AppendInt48 appends 6 bytes to dst and returns the extended slice. Panics if v is not within
+	// [MinInt48, MaxInt48].
+	AppendInt48(dst []byte, v int64) []byte
+
+	// AppendInt56 appends 7 bytes to dst and returns the extended slice. Panics if v is not within
+	// [MinInt56, MaxInt56].
+	AppendInt56(dst []byte, v int64) []byte
+
+	// AppendInt64 appends 8 bytes to dst and returns the extended slice.
+	AppendInt64(dst []byte, v int64) []byte
+
+	// AppendFloat32 appends 4 bytes to dst and returns the extended slice.
+	AppendFloat32(dst []byte, v float32) []byte
+
+	// AppendFloat64 appends 8 bytes to dst and returns the extended slice.
+	AppendFloat64(dst []byte, v float64) []byte
+}
+
+// AppendUint16 appends 2 bytes to dst and returns the extended slice. The receiver is ignored, so a nil
+// LittleEndian is fine, e.g. LE(nil).AppendUint16(dst, v).
+func (LittleEndian) AppendUint16(dst []byte, v uint16) []byte {
+	return append(dst, byte(v), byte(v>>8)) //nolint:gomnd
+}
+
+// AppendUint24 appends 3 bytes to dst and returns the extended slice.
+func (LittleEndian) AppendUint24(dst []byte, v uint32) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16)) //nolint:gomnd
+}
+
+// AppendUint32 appends 4 bytes to dst and returns the extended slice.
+func (LittleEndian) AppendUint32(dst []byte, v uint32) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24)) //nolint:gomnd
+}
+
+// AppendUint40 appends 5 bytes to dst and returns the extended slice.
+func (LittleEndian) AppendUint40(dst []byte, v uint64) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32)) //nolint:gomnd
+}
+
+// AppendUint48 appends 6 bytes to dst and returns the extended slice.
+func (LittleEndian) AppendUint48(dst []byte, v uint64) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32), byte(v>>40)) //nolint:gomnd
+}
+
+// AppendUint56 appends 7 bytes to dst and returns the extended slice.
+func (LittleEndian) AppendUint56(dst []byte, v uint64) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32), byte(v>>40), byte(v>>48)) //nolint:gomnd
+}
+
+// AppendUint64 appends 8 bytes to dst and returns the extended slice.
+func (LittleEndian) AppendUint64(dst []byte, v uint64) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), //nolint:gomnd
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56)) //nolint:gomnd
+}
+
+// AppendInt16 appends 2 bytes to dst and returns the extended slice.
+func (b LittleEndian) AppendInt16(dst []byte, v int16) []byte {
+	return b.AppendUint16(dst, uint16(v))
+}
+
+// AppendInt24 appends 3 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt24, MaxInt24].
+func (b LittleEndian) AppendInt24(dst []byte, v int32) []byte {
+	checkInt(int64(v), int64(MinInt24), int64(MaxInt24), 24) //nolint:gomnd
+
+	return b.AppendUint24(dst, uint32(v))
+}
+
+// AppendInt32 appends 4 bytes to dst and returns the extended slice.
+func (b LittleEndian) AppendInt32(dst []byte, v int32) []byte {
+	return b.AppendUint32(dst, uint32(v))
+}
+
+// AppendInt40 appends 5 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt40, MaxInt40].
+func (b LittleEndian) AppendInt40(dst []byte, v int64) []byte {
+	checkInt(v, MinInt40, MaxInt40, 40) //nolint:gomnd
+
+	return b.AppendUint40(dst, uint64(v))
+}
+
+// AppendInt48 appends 6 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt48, MaxInt48].
+func (b LittleEndian) AppendInt48(dst []byte, v int64) []byte {
+	checkInt(v, MinInt48, MaxInt48, 48) //nolint:gomnd
+
+	return b.AppendUint48(dst, uint64(v))
+}
+
+// AppendInt56 appends 7 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt56, MaxInt56].
+func (b LittleEndian) AppendInt56(dst []byte, v int64) []byte {
+	checkInt(v, MinInt56, MaxInt56, 56) //nolint:gomnd
+
+	return b.AppendUint56(dst, uint64(v))
+}
+
+// AppendInt64 appends 8 bytes to dst and returns the extended slice.
+func (b LittleEndian) AppendInt64(dst []byte, v int64) []byte {
+	return b.AppendUint64(dst, uint64(v))
+}
+
+// AppendFloat32 appends the IEEE 754 4 byte bit sequence of v to dst and returns the extended slice.
+func (b LittleEndian) AppendFloat32(dst []byte, v float32) []byte {
+	return b.AppendUint32(dst, math.Float32bits(v))
+}
+
+// AppendFloat64 appends the IEEE 754 8 byte bit sequence of v to dst and returns the extended slice.
+func (b LittleEndian) AppendFloat64(dst []byte, v float64) []byte {
+	return b.AppendUint64(dst, math.Float64bits(v))
+}
+
+// AppendUint16 appends 2 bytes to dst and returns the extended slice. The receiver is ignored, so a nil
+// BigEndian is fine, e.g. BE(nil).AppendUint16(dst, v).
+func (BigEndian) AppendUint16(dst []byte, v uint16) []byte {
+	return append(dst, byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendUint24 appends 3 bytes to dst and returns the extended slice.
+func (BigEndian) AppendUint24(dst []byte, v uint32) []byte {
+	return append(dst, byte(v>>16), byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendUint32 appends 4 bytes to dst and returns the extended slice.
+func (BigEndian) AppendUint32(dst []byte, v uint32) []byte {
+	return append(dst, byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendUint40 appends 5 bytes to dst and returns the extended slice.
+func (BigEndian) AppendUint40(dst []byte, v uint64) []byte {
+	return append(dst, byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendUint48 appends 6 bytes to dst and returns the extended slice.
+func (BigEndian) AppendUint48(dst []byte, v uint64) []byte {
+	return append(dst, byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendUint56 appends 7 bytes to dst and returns the extended slice.
+func (BigEndian) AppendUint56(dst []byte, v uint64) []byte {
+	return append(dst, byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendUint64 appends 8 bytes to dst and returns the extended slice.
+func (BigEndian) AppendUint64(dst []byte, v uint64) []byte {
+	return append(dst, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), //nolint:gomnd
+		byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) //nolint:gomnd
+}
+
+// AppendInt16 appends 2 bytes to dst and returns the extended slice.
+func (b BigEndian) AppendInt16(dst []byte, v int16) []byte {
+	return b.AppendUint16(dst, uint16(v))
+}
+
+// AppendInt24 appends 3 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt24, MaxInt24].
+func (b BigEndian) AppendInt24(dst []byte, v int32) []byte {
+	checkInt(int64(v), int64(MinInt24), int64(MaxInt24), 24) //nolint:gomnd
+
+	return b.AppendUint24(dst, uint32(v))
+}
+
+// AppendInt32 appends 4 bytes to dst and returns the extended slice.
+func (b BigEndian) AppendInt32(dst []byte, v int32) []byte {
+	return b.AppendUint32(dst, uint32(v))
+}
+
+// AppendInt40 appends 5 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt40, MaxInt40].
+func (b BigEndian) AppendInt40(dst []byte, v int64) []byte {
+	checkInt(v, MinInt40, MaxInt40, 40) //nolint:gomnd
+
+	return b.AppendUint40(dst, uint64(v))
+}
+
+// AppendInt48 appends 6 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt48, MaxInt48].
+func (b BigEndian) AppendInt48(dst []byte, v int64) []byte {
+	checkInt(v, MinInt48, MaxInt48, 48) //nolint:gomnd
+
+	return b.AppendUint48(dst, uint64(v))
+}
+
+// AppendInt56 appends 7 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt56, MaxInt56].
+func (b BigEndian) AppendInt56(dst []byte, v int64) []byte {
+	checkInt(v, MinInt56, MaxInt56, 56) //nolint:gomnd
+
+	return b.AppendUint56(dst, uint64(v))
+}
+
+// AppendInt64 appends 8 bytes to dst and returns the extended slice.
+func (b BigEndian) AppendInt64(dst []byte, v int64) []byte {
+	return b.AppendUint64(dst, uint64(v))
+}
+
+// AppendFloat32 appends the IEEE 754 4 byte bit sequence of v to dst and returns the extended slice.
+func (b BigEndian) AppendFloat32(dst []byte, v float32) []byte {
+	return b.AppendUint32(dst, math.Float32bits(v))
+}
+
+// AppendFloat64 appends the IEEE 754 8 byte bit sequence of v to dst and returns the extended slice.
+func (b BigEndian) AppendFloat64(dst []byte, v float64) []byte {
+	return b.AppendUint64(dst, math.Float64bits(v))
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestAppend(t *testing.T) {
+	prefix := []byte{0xAA}
+
+	for _, order := range []AppendByteOrder{LE(nil), BE(nil)} {
+		var view ByteOrder = LE(src)
+		if _, ok := order.(BigEndian); ok {
+			view = BE(src)
+		}
+
+		for _, tt := range []struct {
+			size        int
+			appendValue func(o AppendByteOrder, dst []byte, b ByteOrder) []byte
+		}{
+			{2, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint16(dst, b.ReadUint16()) }},
+			{3, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint24(dst, b.ReadUint24()) }},
+			{4, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint32(dst, b.ReadUint32()) }},
+			{5, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint40(dst, b.ReadUint40()) }},
+			{6, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint48(dst, b.ReadUint48()) }},
+			{7, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint56(dst, b.ReadUint56()) }},
+			{8, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendUint64(dst, b.ReadUint64()) }},
+			{2, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt16(dst, b.ReadInt16()) }},
+			{3, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt24(dst, b.ReadInt24()) }},
+			{4, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt32(dst, b.ReadInt32()) }},
+			{5, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt40(dst, b.ReadInt40()) }},
+			{6, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt48(dst, b.ReadInt48()) }},
+			{7, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt56(dst, b.ReadInt56()) }},
+			{8, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendInt64(dst, b.ReadInt64()) }},
+			{4, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendFloat32(dst, b.ReadFloat32()) }},
+			{8, func(o AppendByteOrder, dst []byte, b ByteOrder) []byte { return o.AppendFloat64(dst, b.ReadFloat64()) }},
+		} {
+			dst := tt.appendValue(order, prefix[:1:1], view)
+
+			if len(dst) != tt.size+1 {
+				t.Fatalf("%d: unexpected length %d", tt.size, len(dst))
+			}
+
+			if !bytes.Equal(dst[:1], prefix) {
+				t.Fatalf("%d: prefix has been overwritten", tt.size)
+			}
+
+			assertValues(t, dst[1:])
+		}
+	}
+}
+
+func TestAppendOverflow(t *testing.T) {
+	for _, order := range []AppendByteOrder{LE(nil), BE(nil)} {
+		assertOverflow(t, func() { order.AppendInt24(nil, MaxInt24+1) })
+		assertOverflow(t, func() { order.AppendInt40(nil, MinInt40-1) })
+		assertOverflow(t, func() { order.AppendInt48(nil, MaxInt48+1) })
+		assertOverflow(t, func() { order.AppendInt56(nil, MinInt56-1) })
+	}
+}
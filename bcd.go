@@ -0,0 +1,477 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrInvalidBCD is the sentinel for any BCDError and can be matched using errors.Is.
+var ErrInvalidBCD = errors.New("invalid BCD")
+
+// BCDError is returned if a BCD or packed decimal contains a nibble which is neither a digit nor, for the last
+// nibble of a packed decimal, a sign.
+type BCDError struct {
+	// Offset is the position of the byte containing the invalid nibble.
+	Offset int
+
+	// Nibble is the invalid value.
+	Nibble byte
+}
+
+// Error returns a description including the offset and the nibble.
+func (e *BCDError) Error() string {
+	return fmt.Sprintf("byteorder: invalid BCD nibble %X at offset %d", e.Nibble, e.Offset)
+}
+
+// Is returns true if target is ErrInvalidBCD.
+func (e *BCDError) Is(target error) bool {
+	return target == ErrInvalidBCD
+}
+
+// BCD defines the packed binary coded decimal serialization, e.g. used by EMV and ISO 8583 messages, where each
+// byte holds 2 decimal digits, the most significant digit in the high nibble. Values are stored most significant
+// byte first and padded with leading zeros.
+type BCD []byte
+
+// ReadString reads the first n bytes as 2*n decimal digits including leading zeros. Returns a BCDError if a nibble
+// is not a digit. Panics when len(b) < n or n < 1.
+func (b BCD) ReadString(n int) (string, error) {
+	mustDigits(n)
+	mustFit(n, len(b))
+
+	digits := make([]byte, 2*n)
+
+	for i, c := range b[:n] {
+		for j, nibble := range [2]byte{c >> 4, c & 0x0F} { //nolint:gomnd
+			if nibble > 9 { //nolint:gomnd
+				return "", &BCDError{Offset: i, Nibble: nibble}
+			}
+
+			digits[2*i+j] = '0' + nibble
+		}
+	}
+
+	return string(digits), nil
+}
+
+// ReadUint reads the first n bytes as 2*n decimal digits. Returns a BCDError if a nibble is not a digit and an
+// OverflowError if the value does not fit into 64 bit. Panics when len(b) < n or n < 1.
+func (b BCD) ReadUint(n int) (uint64, error) {
+	digits, err := b.ReadString(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseDigits(digits)
+}
+
+// WriteString writes s as 2*n decimal digits, padded with leading zeros. Returns a *strconv.NumError if s is
+// empty or contains other characters than digits and an OverflowError if s has more than 2*n digits. Panics when
+// len(b) < n or n < 1.
+func (b BCD) WriteString(n int, s string) error {
+	mustDigits(n)
+	mustFit(n, len(b))
+
+	if err := checkDigits("BCD.WriteString", s); err != nil {
+		return err
+	}
+
+	if len(s) > 2*n {
+		return &OverflowError{Value: s, Width: 8 * n} //nolint:gomnd
+	}
+
+	packDigits(b[:n], s, 0)
+
+	return nil
+}
+
+// WriteUint writes v as 2*n decimal digits, padded with leading zeros. Returns an OverflowError if v has more
+// than 2*n digits. Panics when len(b) < n or n < 1.
+func (b BCD) WriteUint(n int, v uint64) error {
+	if err := b.WriteString(n, strconv.FormatUint(v, 10)); err != nil {
+		return &OverflowError{Value: v, Width: 8 * n} //nolint:gomnd
+	}
+
+	return nil
+}
+
+// Packed defines the signed packed decimal serialization, also known as COMP-3 on IBM mainframes, where each byte
+// holds 2 decimal digits, except for the last byte, which holds a digit and a trailing sign nibble. The signs C,
+// A, E and F are positive and D and B are negative. Values are written with the sign C or D and padded with leading
+// zeros.
+type Packed []byte
+
+// ReadString reads the first n bytes as 2*n-1 decimal digits including leading zeros, prefixed with a minus for a
+// negative sign. Returns a BCDError if a nibble is not a digit or the sign nibble is invalid. Panics when
+// len(b) < n or n < 1.
+func (b Packed) ReadString(n int) (string, error) {
+	mustDigits(n)
+	mustFit(n, len(b))
+
+	last := b[n-1]
+	sign := last & 0x0F //nolint:gomnd
+
+	if sign < 0x0A { //nolint:gomnd
+		return "", &BCDError{Offset: n - 1, Nibble: sign}
+	}
+
+	buf := append(BCD(nil), b[:n]...)
+	buf[n-1] = last & 0xF0 //nolint:gomnd
+
+	digits, err := buf.ReadString(n)
+	if err != nil {
+		return "", err
+	}
+
+	digits = digits[:2*n-1]
+
+	if sign == 0x0B || sign == 0x0D {
+		digits = "-" + digits
+	}
+
+	return digits, nil
+}
+
+// ReadInt reads the first n bytes as 2*n-1 signed decimal digits. Returns a BCDError if a nibble is not a digit
+// or the sign nibble is invalid and an OverflowError if the value does not fit into 64 bit. Panics when
+// len(b) < n or n < 1.
+func (b Packed) ReadInt(n int) (int64, error) {
+	digits, err := b.ReadString(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseSigned(digits)
+}
+
+// WriteString writes s, optionally prefixed by a plus or minus sign, as 2*n-1 decimal digits padded with leading
+// zeros and a C or D sign nibble. Returns a *strconv.NumError if s contains other characters than digits after
+// the sign and an OverflowError if s has more than 2*n-1 digits. Panics when len(b) < n or n < 1.
+func (b Packed) WriteString(n int, s string) error {
+	mustDigits(n)
+	mustFit(n, len(b))
+
+	digits, neg := splitSign(s)
+
+	if err := checkDigits("Packed.WriteString", digits); err != nil {
+		return err
+	}
+
+	if len(digits) > 2*n-1 {
+		return &OverflowError{Value: s, Width: 8 * n} //nolint:gomnd
+	}
+
+	sign := byte(0x0C)
+	if neg {
+		sign = 0x0D
+	}
+
+	packDigits(b[:n], digits, 1)
+	b[n-1] |= sign
+
+	return nil
+}
+
+// WriteInt writes v as 2*n-1 decimal digits padded with leading zeros and a C or D sign nibble. Returns an
+// OverflowError if v has more than 2*n-1 digits. Panics when len(b) < n or n < 1.
+func (b Packed) WriteInt(n int, v int64) error {
+	if err := b.WriteString(n, formatSigned(v)); err != nil {
+		return &OverflowError{Value: v, Width: 8 * n} //nolint:gomnd
+	}
+
+	return nil
+}
+
+// Zoned defines the signed zoned decimal serialization, also known as DISPLAY on IBM mainframes, where each byte
+// holds a single decimal digit in its low nibble and the EBCDIC zone F in its high nibble, except for the last
+// byte, whose zone nibble holds the sign. Like for Packed, the signs C, A, E and F are positive and D and B are
+// negative. Values are written with the sign C or D and padded with leading zeros.
+type Zoned []byte
+
+// ReadString reads the first n bytes as n decimal digits including leading zeros, prefixed with a minus for a
+// negative sign. Returns a BCDError if a digit, zone or sign nibble is invalid. Panics when len(b) < n or n < 1.
+func (b Zoned) ReadString(n int) (string, error) {
+	mustDigits(n)
+	mustFit(n, len(b))
+
+	digits := make([]byte, n)
+
+	for i, c := range b[:n] {
+		zone, digit := c>>4, c&0x0F //nolint:gomnd
+
+		if digit > 9 { //nolint:gomnd
+			return "", &BCDError{Offset: i, Nibble: digit}
+		}
+
+		if zone != 0x0F && (i < n-1 || zone < 0x0A) {
+			return "", &BCDError{Offset: i, Nibble: zone}
+		}
+
+		digits[i] = '0' + digit
+	}
+
+	if sign := b[n-1] >> 4; sign == 0x0B || sign == 0x0D { //nolint:gomnd
+		return "-" + string(digits), nil
+	}
+
+	return string(digits), nil
+}
+
+// ReadInt reads the first n bytes as n signed decimal digits. Returns a BCDError if a digit, zone or sign nibble
+// is invalid and an OverflowError if the value does not fit into 64 bit. Panics when len(b) < n or n < 1.
+func (b Zoned) ReadInt(n int) (int64, error) {
+	digits, err := b.ReadString(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseSigned(digits)
+}
+
+// WriteString writes s, optionally prefixed by a plus or minus sign, as n decimal digits padded with leading
+// zeros and a C or D sign in the zone of the last byte. Returns a *strconv.NumError if s contains other characters
+// than digits after the sign and an OverflowError if s has more than n digits. Panics when len(b) < n or n < 1.
+func (b Zoned) WriteString(n int, s string) error {
+	mustDigits(n)
+	mustFit(n, len(b))
+
+	digits, neg := splitSign(s)
+
+	if err := checkDigits("Zoned.WriteString", digits); err != nil {
+		return err
+	}
+
+	if len(digits) > n {
+		return &OverflowError{Value: s, Width: 8 * n} //nolint:gomnd
+	}
+
+	pad := n - len(digits)
+
+	for i := range b[:n] {
+		b[i] = 0xF0 //nolint:gomnd
+		if i >= pad {
+			b[i] |= digits[i-pad] - '0'
+		}
+	}
+
+	b[n-1] &= 0x0F //nolint:gomnd
+
+	if neg {
+		b[n-1] |= 0xD0
+	} else {
+		b[n-1] |= 0xC0
+	}
+
+	return nil
+}
+
+// WriteInt writes v as n decimal digits padded with leading zeros and a C or D sign in the zone of the last byte.
+// Returns an OverflowError if v has more than n digits. Panics when len(b) < n or n < 1.
+func (b Zoned) WriteInt(n int, v int64) error {
+	if err := b.WriteString(n, formatSigned(v)); err != nil {
+		return &OverflowError{Value: v, Width: 8 * n} //nolint:gomnd
+	}
+
+	return nil
+}
+
+// mustDigits panics with a ShortBufferError, if a decimal of n bytes cannot hold a single digit.
+func mustDigits(n int) {
+	if n < 1 {
+		panic(shortBuffer(1, n))
+	}
+}
+
+// checkDigits returns a *strconv.NumError, if s is empty or contains other characters than decimal digits.
+func checkDigits(fn, s string) error {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+		}
+	}
+
+	if s == "" {
+		return &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+	}
+
+	return nil
+}
+
+// splitSign removes an optional plus or minus sign from s and returns true, if it was a minus.
+func splitSign(s string) (string, bool) {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		return s[1:], s[0] == '-'
+	}
+
+	return s, false
+}
+
+// formatSigned returns the decimal digits of v, prefixed with a minus if v is negative.
+func formatSigned(v int64) string {
+	if v < 0 {
+		return "-" + strconv.FormatUint(-uint64(v), 10)
+	}
+
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// packDigits writes the decimal digits right-aligned into the nibbles of dst, followed by the given amount of
+// trailing zero nibbles, and pads them with leading zeros. The digits must fit into dst.
+func packDigits(dst []byte, digits string, trailing int) {
+	pad := 2*len(dst) - trailing - len(digits)
+
+	for i := range dst {
+		var nibbles [2]byte
+
+		for j := range nibbles {
+			if k := 2*i + j - pad; k >= 0 && k < len(digits) {
+				nibbles[j] = digits[k] - '0'
+			}
+		}
+
+		dst[i] = nibbles[0]<<4 | nibbles[1] //nolint:gomnd
+	}
+}
+
+// parseDigits parses decimal digits into a uint64. Returns an OverflowError if the value does not fit into 64 bit.
+func parseDigits(digits string) (uint64, error) {
+	v, err := strconv.ParseUint(digits, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &OverflowError{Value: digits, Width: 64} //nolint:gomnd
+	}
+
+	return v, err
+}
+
+// parseSigned parses decimal digits, optionally prefixed with a minus, into an int64. Returns an OverflowError if
+// the value does not fit into 64 bit.
+func parseSigned(s string) (int64, error) {
+	digits, neg := splitSign(s)
+
+	u, err := parseDigits(digits)
+
+	switch {
+	case err != nil:
+		return 0, err
+	case neg && u <= 1<<63:
+		return -int64(u), nil
+	case !neg && u < 1<<63:
+		return int64(u), nil
+	default:
+		return 0, &OverflowError{Value: s, Width: 64} //nolint:gomnd
+	}
+}
+
+// ReadBCD reads the next n bytes as 2*n BCD digits and advances the position, even if the digits are invalid. See
+// BCD.ReadUint for the errors. Panics when Remaining() < n or n < 1.
+func (r *Reader) ReadBCD(n int) (uint64, error) {
+	return BCD(r.nextDigits(n)).ReadUint(n)
+}
+
+// ReadBCDString reads the next n bytes as 2*n BCD digits including leading zeros and advances the position, even
+// if the digits are invalid. See BCD.ReadString for the errors. Panics when Remaining() < n or n < 1.
+func (r *Reader) ReadBCDString(n int) (string, error) {
+	return BCD(r.nextDigits(n)).ReadString(n)
+}
+
+// ReadPacked reads the next n bytes as a packed decimal of 2*n-1 digits and advances the position, even if the
+// digits are invalid. See Packed.ReadInt for the errors. Panics when Remaining() < n or n < 1.
+func (r *Reader) ReadPacked(n int) (int64, error) {
+	return Packed(r.nextDigits(n)).ReadInt(n)
+}
+
+// ReadPackedString reads the next n bytes as a packed decimal of 2*n-1 digits including leading zeros and advances
+// the position, even if the digits are invalid. See Packed.ReadString for the errors. Panics when Remaining() < n
+// or n < 1.
+func (r *Reader) ReadPackedString(n int) (string, error) {
+	return Packed(r.nextDigits(n)).ReadString(n)
+}
+
+// ReadZoned reads the next n bytes as a zoned decimal of n digits and advances the position, even if the digits
+// are invalid. See Zoned.ReadInt for the errors. Panics when Remaining() < n or n < 1.
+func (r *Reader) ReadZoned(n int) (int64, error) {
+	return Zoned(r.nextDigits(n)).ReadInt(n)
+}
+
+// ReadZonedString reads the next n bytes as a zoned decimal of n digits including leading zeros and advances the
+// position, even if the digits are invalid. See Zoned.ReadString for the errors. Panics when Remaining() < n or
+// n < 1.
+func (r *Reader) ReadZonedString(n int) (string, error) {
+	return Zoned(r.nextDigits(n)).ReadString(n)
+}
+
+// nextDigits returns the next n bytes of a decimal and advances the position. Panics when Remaining() < n or
+// n < 1.
+func (r *Reader) nextDigits(n int) []byte {
+	mustDigits(n)
+	mustFit(n, r.Remaining())
+
+	return r.Next(n)
+}
+
+// AppendBCD appends v as n bytes of 2*n BCD digits. Returns an OverflowError without appending anything if v has
+// more than 2*n digits. Panics if n < 1.
+func (b *Buffer) AppendBCD(n int, v uint64) error {
+	return b.appendDigits(n, func(tmp []byte) error { return BCD(tmp).WriteUint(n, v) })
+}
+
+// AppendBCDString appends s as n bytes of 2*n BCD digits. See BCD.WriteString for the errors, in which case
+// nothing is appended. Panics if n < 1.
+func (b *Buffer) AppendBCDString(n int, s string) error {
+	return b.appendDigits(n, func(tmp []byte) error { return BCD(tmp).WriteString(n, s) })
+}
+
+// AppendPacked appends v as a packed decimal of n bytes. Returns an OverflowError without appending anything if v
+// has more than 2*n-1 digits. Panics if n < 1.
+func (b *Buffer) AppendPacked(n int, v int64) error {
+	return b.appendDigits(n, func(tmp []byte) error { return Packed(tmp).WriteInt(n, v) })
+}
+
+// AppendPackedString appends s as a packed decimal of n bytes. See Packed.WriteString for the errors, in which
+// case nothing is appended. Panics if n < 1.
+func (b *Buffer) AppendPackedString(n int, s string) error {
+	return b.appendDigits(n, func(tmp []byte) error { return Packed(tmp).WriteString(n, s) })
+}
+
+// AppendZoned appends v as a zoned decimal of n bytes. Returns an OverflowError without appending anything if v
+// has more than n digits. Panics if n < 1.
+func (b *Buffer) AppendZoned(n int, v int64) error {
+	return b.appendDigits(n, func(tmp []byte) error { return Zoned(tmp).WriteInt(n, v) })
+}
+
+// AppendZonedString appends s as a zoned decimal of n bytes. See Zoned.WriteString for the errors, in which case
+// nothing is appended. Panics if n < 1.
+func (b *Buffer) AppendZonedString(n int, s string) error {
+	return b.appendDigits(n, func(tmp []byte) error { return Zoned(tmp).WriteString(n, s) })
+}
+
+// appendDigits appends n bytes encoded by write, unless it fails. Panics if n < 1.
+func (b *Buffer) appendDigits(n int, write func([]byte) error) error {
+	mustDigits(n)
+
+	tmp := make([]byte, n)
+	if err := write(tmp); err != nil {
+		return err
+	}
+
+	b.buf = append(b.buf, tmp...)
+
+	return nil
+}
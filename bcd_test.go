@@ -0,0 +1,379 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestBCD(t *testing.T) {
+	// ISO 8583 amount field 4 with 12 digits
+	amount := []byte{0x00, 0x00, 0x00, 0x01, 0x23, 0x45}
+
+	if v, err := BCD(amount).ReadUint(6); err != nil || v != 12345 {
+		t.Fatalf("expected 12345 but got %d %v", v, err)
+	}
+
+	if s, err := BCD(amount).ReadString(6); err != nil || s != "000000012345" {
+		t.Fatalf("expected 000000012345 but got %s %v", s, err)
+	}
+
+	tmp := make(BCD, 6)
+	if err := tmp.WriteUint(6, 12345); err != nil || !bytes.Equal(tmp, amount) {
+		t.Fatalf("expected %X but got %X %v", amount, tmp, err)
+	}
+
+	// EMV date YYMMDD
+	if err := tmp.WriteString(3, "261018"); err != nil || !bytes.Equal(tmp[:3], []byte{0x26, 0x10, 0x18}) {
+		t.Fatalf("unexpected date %X %v", tmp[:3], err)
+	}
+
+	max := BCD{0x18, 0x44, 0x67, 0x44, 0x07, 0x37, 0x09, 0x55, 0x16, 0x15}
+	if v, err := max.ReadUint(10); err != nil || v != 1<<64-1 {
+		t.Fatalf("expected max uint64 but got %d %v", v, err)
+	}
+
+	tmp = make(BCD, 10)
+	if err := tmp.WriteUint(10, 1<<64-1); err != nil || !bytes.Equal(tmp, max) {
+		t.Fatalf("expected %X but got %X %v", max, tmp, err)
+	}
+}
+
+func TestBCDErrors(t *testing.T) {
+	var bcdErr *BCDError
+
+	if _, err := BCD([]byte{0x12, 0x3A}).ReadUint(2); !errors.As(err, &bcdErr) || !errors.Is(err, ErrInvalidBCD) ||
+		bcdErr.Offset != 1 || bcdErr.Nibble != 0x0A {
+		t.Fatalf("expected invalid nibble but got %v", err)
+	}
+
+	if _, err := BCD([]byte{0xF1}).ReadString(1); !errors.Is(err, ErrInvalidBCD) || err.Error() == "" {
+		t.Fatalf("expected invalid nibble but got %v", err)
+	}
+
+	overflow := BCD{0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99}
+	if _, err := overflow.ReadUint(len(overflow)); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	tmp := make(BCD, 2)
+	if err := tmp.WriteUint(2, 10000); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	if err := tmp.WriteString(2, "12345"); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	for _, s := range []string{"", "12a", "-1"} {
+		if err := tmp.WriteString(2, s); !errors.Is(err, strconv.ErrSyntax) {
+			t.Fatalf("%q: expected syntax error but got %v", s, err)
+		}
+	}
+
+	assertBulkShort(t, func() { _, _ = BCD([]byte{1}).ReadUint(2) })
+	assertBulkShort(t, func() { _ = BCD([]byte{1}).WriteUint(2, 1) })
+	assertBulkShort(t, func() { _ = BCD([]byte{1}).WriteString(2, "1") })
+	assertBulkShort(t, func() { _, _ = BCD(nil).ReadUint(0) })
+	assertBulkShort(t, func() { _, _ = BCD(nil).ReadString(0) })
+	assertBulkShort(t, func() { _ = BCD(nil).WriteUint(0, 0) })
+	assertBulkShort(t, func() { _ = BCD(nil).WriteString(0, "0") })
+}
+
+func TestPacked(t *testing.T) {
+	for _, tt := range []struct {
+		b []byte
+		v int64
+		s string
+	}{
+		{[]byte{0x12, 0x34, 0x5C}, 12345, "12345"},
+		{[]byte{0x00, 0x12, 0x3D}, -123, "-00123"},
+		{[]byte{0x0C}, 0, "0"},
+		{[]byte{0x92, 0x23, 0x37, 0x20, 0x36, 0x85, 0x47, 0x75, 0x80, 0x8D}, MinInt64, "-9223372036854775808"},
+		{[]byte{0x92, 0x23, 0x37, 0x20, 0x36, 0x85, 0x47, 0x75, 0x80, 0x7C}, MaxInt64, "9223372036854775807"},
+	} {
+		n := len(tt.b)
+
+		if v, err := Packed(tt.b).ReadInt(n); err != nil || v != tt.v {
+			t.Fatalf("%X: expected %d but got %d %v", tt.b, tt.v, v, err)
+		}
+
+		if s, err := Packed(tt.b).ReadString(n); err != nil || s != tt.s {
+			t.Fatalf("%X: expected %s but got %s %v", tt.b, tt.s, s, err)
+		}
+
+		tmp := make(Packed, n)
+		if err := tmp.WriteInt(n, tt.v); err != nil || !bytes.Equal(tmp, tt.b) {
+			t.Fatalf("%d: expected %X but got %X %v", tt.v, tt.b, tmp, err)
+		}
+
+		tmp = make(Packed, n)
+		if err := tmp.WriteString(n, tt.s); err != nil || !bytes.Equal(tmp, tt.b) {
+			t.Fatalf("%s: expected %X but got %X %v", tt.s, tt.b, tmp, err)
+		}
+	}
+
+	// alternative sign nibbles
+	for sign, v := range map[byte]int64{0x0A: 7, 0x0B: -7, 0x0E: 7, 0x0F: 7} {
+		if got, err := Packed([]byte{0x70 | sign}).ReadInt(1); err != nil || got != v {
+			t.Fatalf("%X: expected %d but got %d %v", sign, v, got, err)
+		}
+	}
+
+	tmp := make(Packed, 2)
+	if err := tmp.WriteString(2, "+42"); err != nil || !bytes.Equal(tmp, []byte{0x04, 0x2C}) {
+		t.Fatalf("unexpected %X %v", tmp, err)
+	}
+}
+
+func TestPackedErrors(t *testing.T) {
+	var bcdErr *BCDError
+
+	if _, err := Packed([]byte{0x12, 0x34}).ReadInt(2); !errors.As(err, &bcdErr) || bcdErr.Offset != 1 ||
+		bcdErr.Nibble != 4 {
+		t.Fatalf("expected invalid sign but got %v", err)
+	}
+
+	if _, err := Packed([]byte{0x1A, 0x3C}).ReadInt(2); !errors.As(err, &bcdErr) || bcdErr.Offset != 0 ||
+		bcdErr.Nibble != 0x0A {
+		t.Fatalf("expected invalid digit but got %v", err)
+	}
+
+	overflow := []byte{0x92, 0x23, 0x37, 0x20, 0x36, 0x85, 0x47, 0x75, 0x80, 0x8C}
+	if _, err := Packed(overflow).ReadInt(len(overflow)); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	if _, err := Packed(append(bytes.Repeat([]byte{0x99}, 10), 0x9C)).ReadInt(11); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	tmp := make(Packed, 2)
+	if err := tmp.WriteInt(2, 1000); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	if err := tmp.WriteString(2, "-1000"); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	for _, s := range []string{"", "-", "+-1", "1.5"} {
+		if err := tmp.WriteString(2, s); !errors.Is(err, strconv.ErrSyntax) {
+			t.Fatalf("%q: expected syntax error but got %v", s, err)
+		}
+	}
+
+	assertBulkShort(t, func() { _, _ = Packed(nil).ReadInt(0) })
+	assertBulkShort(t, func() { _, _ = Packed([]byte{0x1C}).ReadInt(2) })
+	assertBulkShort(t, func() { _ = Packed(nil).WriteInt(0, 1) })
+	assertBulkShort(t, func() { _ = Packed([]byte{0x1C}).WriteString(2, "1") })
+}
+
+func TestZoned(t *testing.T) {
+	for _, tt := range []struct {
+		b []byte
+		v int64
+		s string
+	}{
+		{[]byte{0xF1, 0xF2, 0xC3}, 123, "123"},
+		{[]byte{0xF0, 0xF4, 0xD2}, -42, "-042"},
+		{[]byte{0xC0}, 0, "0"},
+		{[]byte{0xF9, 0xF2, 0xF2, 0xF3, 0xF3, 0xF7, 0xF2, 0xF0, 0xF3, 0xF6, 0xF8, 0xF5, 0xF4, 0xF7, 0xF7, 0xF5, 0xF8, 0xF0,
+			0xD8}, MinInt64, "-9223372036854775808"},
+	} {
+		n := len(tt.b)
+
+		if v, err := Zoned(tt.b).ReadInt(n); err != nil || v != tt.v {
+			t.Fatalf("%X: expected %d but got %d %v", tt.b, tt.v, v, err)
+		}
+
+		if s, err := Zoned(tt.b).ReadString(n); err != nil || s != tt.s {
+			t.Fatalf("%X: expected %s but got %s %v", tt.b, tt.s, s, err)
+		}
+
+		tmp := make(Zoned, n)
+		if err := tmp.WriteInt(n, tt.v); err != nil || !bytes.Equal(tmp, tt.b) {
+			t.Fatalf("%d: expected %X but got %X %v", tt.v, tt.b, tmp, err)
+		}
+
+		tmp = make(Zoned, n)
+		if err := tmp.WriteString(n, tt.s); err != nil || !bytes.Equal(tmp, tt.b) {
+			t.Fatalf("%s: expected %X but got %X %v", tt.s, tt.b, tmp, err)
+		}
+	}
+
+	// unsigned zone and alternative signs in the last byte
+	for _, tt := range []struct {
+		b []byte
+		v int64
+	}{
+		{[]byte{0xF1, 0xF7}, 17},
+		{[]byte{0xF1, 0xA7}, 17},
+		{[]byte{0xF1, 0xE7}, 17},
+		{[]byte{0xF1, 0xB7}, -17},
+	} {
+		if v, err := Zoned(tt.b).ReadInt(len(tt.b)); err != nil || v != tt.v {
+			t.Fatalf("%X: expected %d but got %d %v", tt.b, tt.v, v, err)
+		}
+	}
+
+	tmp := make(Zoned, 2)
+	if err := tmp.WriteString(2, "+7"); err != nil || !bytes.Equal(tmp, []byte{0xF0, 0xC7}) {
+		t.Fatalf("unexpected %X %v", tmp, err)
+	}
+}
+
+func TestZonedErrors(t *testing.T) {
+	for _, tt := range []struct {
+		b      []byte
+		offset int
+		nibble byte
+	}{
+		{[]byte{0xF1, 0xFA, 0xC3}, 1, 0x0A},
+		{[]byte{0xC1, 0xF2, 0xC3}, 0, 0x0C},
+		{[]byte{0x31, 0x32, 0x33}, 0, 0x03},
+		{[]byte{0xF1, 0xF2, 0x93}, 2, 0x09},
+		{[]byte{0xF1, 0xB2, 0xC3}, 1, 0x0B},
+	} {
+		var bcdErr *BCDError
+		if _, err := Zoned(tt.b).ReadInt(len(tt.b)); !errors.As(err, &bcdErr) || !errors.Is(err, ErrInvalidBCD) ||
+			bcdErr.Offset != tt.offset || bcdErr.Nibble != tt.nibble {
+			t.Fatalf("%X: expected invalid nibble but got %v", tt.b, err)
+		}
+	}
+
+	overflow := []byte{0xF9, 0xF2, 0xF2, 0xF3, 0xF3, 0xF7, 0xF2, 0xF0, 0xF3, 0xF6, 0xF8, 0xF5, 0xF4, 0xF7, 0xF7, 0xF5,
+		0xF8, 0xF0, 0xC8}
+	if _, err := Zoned(overflow).ReadInt(len(overflow)); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	tmp := make(Zoned, 2)
+	if err := tmp.WriteInt(2, -100); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	if err := tmp.WriteString(2, "123"); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	for _, s := range []string{"", "+", "1-", "1e"} {
+		if err := tmp.WriteString(2, s); !errors.Is(err, strconv.ErrSyntax) {
+			t.Fatalf("%q: expected syntax error but got %v", s, err)
+		}
+	}
+
+	assertBulkShort(t, func() { _, _ = Zoned(nil).ReadInt(0) })
+	assertBulkShort(t, func() { _, _ = Zoned([]byte{0xC1}).ReadString(2) })
+	assertBulkShort(t, func() { _ = Zoned(nil).WriteInt(0, 1) })
+	assertBulkShort(t, func() { _ = Zoned([]byte{0xC1}).WriteString(2, "1") })
+}
+
+func TestReaderBCD(t *testing.T) {
+	b := NewBuffer(nil, Big)
+
+	if err := b.AppendBCD(2, 2610); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.AppendPacked(3, -12345); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.AppendBCD(1, 100); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	if err := b.AppendPacked(1, 10); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+
+	if expected := []byte{0x26, 0x10, 0x12, 0x34, 0x5D}; !bytes.Equal(b.Bytes(), expected) {
+		t.Fatalf("expected %X but got %X", expected, b.Bytes())
+	}
+
+	r := NewReader(b.Bytes(), Big)
+
+	if v, err := r.ReadBCD(2); err != nil || v != 2610 {
+		t.Fatalf("expected 2610 but got %d %v", v, err)
+	}
+
+	if v, err := r.ReadPacked(3); err != nil || v != -12345 {
+		t.Fatalf("expected -12345 but got %d %v", v, err)
+	}
+
+	assertBulkShort(t, func() { _, _ = r.ReadBCD(1) })
+	assertBulkShort(t, func() { _, _ = r.ReadPacked(1) })
+	assertBulkShort(t, func() { _, _ = NewReader([]byte{0}, Big).ReadBCD(0) })
+	assertBulkShort(t, func() { _ = b.AppendBCD(0, 0) })
+}
+
+func TestReaderDecimalStrings(t *testing.T) {
+	b := NewBuffer(nil, Little)
+
+	// 10 byte ISO 8583 amount exceeding 64 bit
+	for _, err := range []error{
+		b.AppendBCDString(10, "99999999999999999999"),
+		b.AppendPackedString(11, "-123456789012345678901"),
+		b.AppendZoned(3, -5),
+		b.AppendZonedString(21, "123456789012345678901"),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, err := range []error{
+		b.AppendBCDString(1, "123"),
+		b.AppendPackedString(1, "x"),
+		b.AppendZoned(1, 10),
+		b.AppendZonedString(1, "12"),
+	} {
+		if err == nil {
+			t.Fatalf("expected error")
+		}
+	}
+
+	if b.Len() != 10+11+3+21 {
+		t.Fatalf("unexpected length %d", b.Len())
+	}
+
+	r := NewReader(b.Bytes(), Little)
+
+	if s, err := r.ReadBCDString(10); err != nil || s != "99999999999999999999" {
+		t.Fatalf("unexpected %s %v", s, err)
+	}
+
+	if s, err := r.ReadPackedString(11); err != nil || s != "-123456789012345678901" {
+		t.Fatalf("unexpected %s %v", s, err)
+	}
+
+	if v, err := r.ReadZoned(3); err != nil || v != -5 {
+		t.Fatalf("unexpected %d %v", v, err)
+	}
+
+	if s, err := r.ReadZonedString(21); err != nil || s != "123456789012345678901" {
+		t.Fatalf("unexpected %s %v", s, err)
+	}
+
+	assertBulkShort(t, func() { _, _ = r.ReadZoned(1) })
+}
@@ -149,6 +149,84 @@ func (b BigEndian) WriteUint64(v uint64) {
 	b[7] = byte(v)
 }
 
+// ReadInt16 reads the first 2 bytes as a signed two's complement integer. Panics when len(b) < 2.
+func (b BigEndian) ReadInt16() int16 {
+	return int16(b.ReadUint16())
+}
+
+// WriteInt16 writes the first 2 bytes. Panics when len(b) < 2.
+func (b BigEndian) WriteInt16(v int16) {
+	b.WriteUint16(uint16(v))
+}
+
+// ReadInt24 reads the first 3 bytes and sign extends the 24 bit two's complement value.
+// Panics when len(b) < 3.
+func (b BigEndian) ReadInt24() int32 {
+	return int32(signExtend(uint64(b.ReadUint24()), 24)) //nolint:gomnd
+}
+
+// WriteInt24 writes the first 3 bytes. Panics when len(b) < 3 or if v is not within [MinInt24, MaxInt24].
+func (b BigEndian) WriteInt24(v int32) {
+	checkInt(int64(v), int64(MinInt24), int64(MaxInt24), 24) //nolint:gomnd
+	b.WriteUint24(uint32(v))
+}
+
+// ReadInt32 reads the first 4 bytes as a signed two's complement integer. Panics when len(b) < 4.
+func (b BigEndian) ReadInt32() int32 {
+	return int32(b.ReadUint32())
+}
+
+// WriteInt32 writes the first 4 bytes. Panics when len(b) < 4.
+func (b BigEndian) WriteInt32(v int32) {
+	b.WriteUint32(uint32(v))
+}
+
+// ReadInt40 reads the first 5 bytes and sign extends the 40 bit two's complement value.
+// Panics when len(b) < 5.
+func (b BigEndian) ReadInt40() int64 {
+	return int64(signExtend(uint64(b.ReadUint40()), 40)) //nolint:gomnd
+}
+
+// WriteInt40 writes the first 5 bytes. Panics when len(b) < 5 or if v is not within [MinInt40, MaxInt40].
+func (b BigEndian) WriteInt40(v int64) {
+	checkInt(v, MinInt40, MaxInt40, 40) //nolint:gomnd
+	b.WriteUint40(uint64(v))
+}
+
+// ReadInt48 reads the first 6 bytes and sign extends the 48 bit two's complement value.
+// Panics when len(b) < 6.
+func (b BigEndian) ReadInt48() int64 {
+	return int64(signExtend(uint64(b.ReadUint48()), 48)) //nolint:gomnd
+}
+
+// WriteInt48 writes the first 6 bytes. Panics when len(b) < 6 or if v is not within [MinInt48, MaxInt48].
+func (b BigEndian) WriteInt48(v int64) {
+	checkInt(v, MinInt48, MaxInt48, 48) //nolint:gomnd
+	b.WriteUint48(uint64(v))
+}
+
+// ReadInt56 reads the first 7 bytes and sign extends the 56 bit two's complement value.
+// Panics when len(b) < 7.
+func (b BigEndian) ReadInt56() int64 {
+	return int64(signExtend(uint64(b.ReadUint56()), 56)) //nolint:gomnd
+}
+
+// WriteInt56 writes the first 7 bytes. Panics when len(b) < 7 or if v is not within [MinInt56, MaxInt56].
+func (b BigEndian) WriteInt56(v int64) {
+	checkInt(v, MinInt56, MaxInt56, 56) //nolint:gomnd
+	b.WriteUint56(uint64(v))
+}
+
+// ReadInt64 reads the first 8 bytes as a signed two's complement integer. Panics when len(b) < 8.
+func (b BigEndian) ReadInt64() int64 {
+	return int64(b.ReadUint64())
+}
+
+// WriteInt64 writes the first 8 bytes. Panics when len(b) < 8.
+func (b BigEndian) WriteInt64(v int64) {
+	b.WriteUint64(uint64(v))
+}
+
 // ReadFloat64 reads 8 bytes and interprets them as a float64 IEEE 754 4 byte bit sequence.
 // Panics when len(b) < 8.
 func (b BigEndian) ReadFloat64() float64 {
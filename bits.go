@@ -0,0 +1,307 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// BitOrder determines in which order the bits of a byte are consumed or produced by a BitReader or BitWriter.
+type BitOrder uint8
+
+const (
+	// MSBFirst starts at the most significant bit of each byte and values are stored with their most significant
+	// bit first, e.g. as used by H.264, MPEG or JPEG.
+	MSBFirst BitOrder = iota
+	// LSBFirst starts at the least significant bit of each byte and values are stored with their least significant
+	// bit first, e.g. as used by DEFLATE or GIF.
+	LSBFirst
+)
+
+// String returns MSBFirst or LSBFirst.
+func (o BitOrder) String() string {
+	switch o {
+	case MSBFirst:
+		return "MSBFirst"
+	case LSBFirst:
+		return "LSBFirst"
+	default:
+		return "BitOrder(" + strconv.Itoa(int(o)) + ")"
+	}
+}
+
+// mustBits panics, if the number of bits n is not within [min, 64].
+func mustBits(n, min int) {
+	if n < min || n > 64 {
+		panic("byteorder: invalid bit count of " + strconv.Itoa(n))
+	}
+}
+
+// minBits returns the smaller bit count of a and b.
+func minBits(a, b uint) uint {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// A BitReader decodes values of 1 to 64 bits from an io.Reader. If the stream ends in the middle of a value,
+// io.ErrUnexpectedEOF is returned. If the stream ends before the first bit of a value, io.EOF is returned.
+// A failed read does not consume any bits.
+type BitReader struct {
+	r     io.Reader
+	buf   [9]byte // up to 7 consumed bits and 64 pending bits
+	len   int     // number of buffered bytes in buf
+	bit   uint    // number of already consumed bits of buf[0]
+	order BitOrder
+}
+
+// NewBitReader creates a BitReader which decodes values in the given bit order from r.
+func NewBitReader(r io.Reader, order BitOrder) *BitReader {
+	return &BitReader{r: r, order: order}
+}
+
+// NewBitReaderBytes creates a BitReader which decodes values in the given bit order from b.
+func NewBitReaderBytes(b []byte, order BitOrder) *BitReader {
+	return NewBitReader(bytes.NewReader(b), order)
+}
+
+// BitOrder returns the bit order of the reader.
+func (r *BitReader) BitOrder() BitOrder {
+	return r.order
+}
+
+// fill ensures that at least n unconsumed bits are buffered.
+func (r *BitReader) fill(n int) error {
+	need := (int(r.bit) + n + 7) / 8 //nolint:gomnd
+	if need <= r.len {
+		return nil
+	}
+
+	m, err := io.ReadFull(r.r, r.buf[r.len:need])
+	r.len += m
+
+	if err != nil && r.len > 0 && errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// extract returns the next n buffered bits without consuming them.
+func (r *BitReader) extract(n int) uint64 {
+	var v uint64
+
+	pos := r.bit
+
+	for got := uint(0); got < uint(n); {
+		off := pos % 8 //nolint:gomnd
+		k := minBits(8-off, uint(n)-got)
+		b := uint64(r.buf[pos/8])
+
+		if r.order == LSBFirst {
+			v |= (b >> off) & (1<<k - 1) << got
+		} else {
+			v = v<<k | (b>>(8-off-k))&(1<<k-1)
+		}
+
+		got += k
+		pos += k
+	}
+
+	return v
+}
+
+// consume discards the next n buffered bits.
+func (r *BitReader) consume(n int) {
+	pos := int(r.bit) + n
+	r.len = copy(r.buf[:], r.buf[pos/8:r.len])
+	r.bit = uint(pos % 8) //nolint:gomnd
+}
+
+// PeekBits returns the next n bits as an unsigned value without consuming them, where n must be within [0, 64].
+// Panics if n is invalid.
+func (r *BitReader) PeekBits(n int) (uint64, error) {
+	mustBits(n, 0)
+
+	if err := r.fill(n); err != nil {
+		return 0, err
+	}
+
+	return r.extract(n), nil
+}
+
+// ReadBits reads the next n bits as an unsigned value, where n must be within [0, 64]. Panics if n is invalid.
+func (r *BitReader) ReadBits(n int) (uint64, error) {
+	v, err := r.PeekBits(n)
+	if err != nil {
+		return 0, err
+	}
+
+	r.consume(n)
+
+	return v, nil
+}
+
+// ReadSignedBits reads the next n bits as a sign extended two's complement value, where n must be within [1, 64].
+// Panics if n is invalid.
+func (r *BitReader) ReadSignedBits(n int) (int64, error) {
+	mustBits(n, 1)
+
+	v, err := r.ReadBits(n)
+
+	return signExtend(v, uint(n)), err
+}
+
+// ReadBit reads the next bit.
+func (r *BitReader) ReadBit() (bool, error) {
+	v, err := r.ReadBits(1)
+
+	return v == 1, err
+}
+
+// SkipBits discards the next n bits. Returns io.ErrUnexpectedEOF or io.EOF if the stream ends before, in which
+// case all available bits have been discarded.
+func (r *BitReader) SkipBits(n int) error {
+	for n > 0 {
+		k := int(minBits(uint(n), 64)) //nolint:gomnd
+		if err := r.fill(k); err != nil {
+			r.consume(r.len*8 - int(r.bit)) //nolint:gomnd
+
+			return err
+		}
+
+		r.consume(k)
+		n -= k
+	}
+
+	return nil
+}
+
+// Aligned returns true, if the next bit is the first bit of a byte.
+func (r *BitReader) Aligned() bool {
+	return r.bit == 0
+}
+
+// Align discards the remaining bits of a partially consumed byte, so that the next read starts at a byte boundary.
+func (r *BitReader) Align() {
+	if r.bit != 0 {
+		r.consume(8 - int(r.bit)) //nolint:gomnd
+	}
+}
+
+// A BitWriter encodes values of 1 to 64 bits into an io.Writer. Bits are collected until a byte is complete, so
+// Align must be called after the last value to write out a trailing partial byte. Use a bytes.Buffer to encode
+// into a byte slice.
+type BitWriter struct {
+	w     io.Writer
+	acc   byte // pending bits of an incomplete byte
+	bit   uint // number of pending bits in acc
+	order BitOrder
+}
+
+// NewBitWriter creates a BitWriter which encodes values in the given bit order into w.
+func NewBitWriter(w io.Writer, order BitOrder) *BitWriter {
+	return &BitWriter{w: w, order: order}
+}
+
+// BitOrder returns the bit order of the writer.
+func (w *BitWriter) BitOrder() BitOrder {
+	return w.order
+}
+
+// WriteBits writes the lowest n bits of v, where n must be within [0, 64]. Each completed byte is written
+// immediately. Returns an OverflowError if v does not fit into n bits. Panics if n is invalid.
+func (w *BitWriter) WriteBits(n int, v uint64) error {
+	mustBits(n, 0)
+
+	if v > MaxUint64>>(64-n) {
+		return &OverflowError{Value: v, Width: n}
+	}
+
+	var out [9]byte
+
+	count := 0
+
+	for rem := uint(n); rem > 0; {
+		k := minBits(8-w.bit, rem) //nolint:gomnd
+
+		if w.order == LSBFirst {
+			w.acc |= byte(v&(1<<k-1)) << w.bit
+			v >>= k
+		} else {
+			w.acc |= byte(v>>(rem-k)&(1<<k-1)) << (8 - w.bit - k)
+		}
+
+		rem -= k
+		w.bit += k
+
+		if w.bit == 8 { //nolint:gomnd
+			out[count] = w.acc
+			count++
+			w.acc, w.bit = 0, 0
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	_, err := w.w.Write(out[:count])
+
+	return err
+}
+
+// WriteSignedBits writes the lowest n bits of the two's complement value v, where n must be within [1, 64].
+// Returns an OverflowError if v does not fit into n bits. Panics if n is invalid.
+func (w *BitWriter) WriteSignedBits(n int, v int64) error {
+	mustBits(n, 1)
+
+	if err := intOverflow(v, -1<<(n-1), 1<<(n-1)-1, n); err != nil {
+		return err
+	}
+
+	return w.WriteBits(n, uint64(v)&(MaxUint64>>(64-n)))
+}
+
+// WriteBit writes a single bit.
+func (w *BitWriter) WriteBit(v bool) error {
+	if v {
+		return w.WriteBits(1, 1)
+	}
+
+	return w.WriteBits(1, 0)
+}
+
+// Aligned returns true, if no bits of an incomplete byte are pending.
+func (w *BitWriter) Aligned() bool {
+	return w.bit == 0
+}
+
+// Align pads a pending incomplete byte with zero bits and writes it, so that the next value starts at a byte
+// boundary.
+func (w *BitWriter) Align() error {
+	if w.bit == 0 {
+		return nil
+	}
+
+	return w.WriteBits(int(8-w.bit), 0) //nolint:gomnd
+}
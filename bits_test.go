@@ -0,0 +1,229 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestBitReader(t *testing.T) {
+	tests := []struct {
+		order    BitOrder
+		expected []uint64
+	}{
+		{MSBFirst, []uint64{1, 0b011, 0b0001, 1, 0b011_1111}},
+		{LSBFirst, []uint64{1, 0b000, 0b1011, 1, 0b101_1111}},
+	}
+
+	for _, tt := range tests {
+		r := NewBitReaderBytes([]byte{0b1011_0001, 0b1011_1111}, tt.order)
+
+		for i, n := range []int{1, 3, 4, 1, 7} {
+			v, err := r.ReadBits(n)
+			if err != nil || v != tt.expected[i] {
+				t.Fatalf("%v %d: expected %b but got %b (%v)", tt.order, i, tt.expected[i], v, err)
+			}
+		}
+
+		if _, err := r.ReadBit(); !errors.Is(err, io.EOF) {
+			t.Fatalf("%v: expected EOF but got %v", tt.order, err)
+		}
+	}
+}
+
+func TestBitReaderByteOrder(t *testing.T) {
+	for n := 1; n <= 8; n++ {
+		v, err := NewBitReaderBytes(src, MSBFirst).ReadBits(8 * n)
+		if err != nil || v != BE(src).ReadUint(n) {
+			t.Fatalf("%d: expected %x but got %x (%v)", n, BE(src).ReadUint(n), v, err)
+		}
+
+		v, err = NewBitReaderBytes(src, LSBFirst).ReadBits(8 * n)
+		if err != nil || v != LE(src).ReadUint(n) {
+			t.Fatalf("%d: expected %x but got %x (%v)", n, LE(src).ReadUint(n), v, err)
+		}
+	}
+}
+
+func TestBitRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1)) //nolint:gosec
+
+	for _, order := range []BitOrder{MSBFirst, LSBFirst} {
+		var (
+			buf    bytes.Buffer
+			sizes  []int
+			vals   []uint64
+			signed []int64
+		)
+
+		w := NewBitWriter(&buf, order)
+		if w.BitOrder() != order {
+			t.Fatalf("unexpected order %v", w.BitOrder())
+		}
+
+		for i := 0; i < 1000; i++ {
+			n := rnd.Intn(65)
+			v := rnd.Uint64() & (MaxUint64 >> (64 - n))
+
+			if err := w.WriteBits(n, v); err != nil {
+				t.Fatal(err)
+			}
+
+			sizes = append(sizes, n)
+			vals = append(vals, v)
+
+			sv := int64(rnd.Uint64()) >> (64 - n%64 - 1)
+			signed = append(signed, sv)
+
+			if err := w.WriteSignedBits(n%64+1, sv); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := w.WriteBit(false); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := w.WriteBit(true); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			if err := w.Align(); err != nil || !w.Aligned() {
+				t.Fatalf("unexpected align error %v", err)
+			}
+		}
+
+		r := NewBitReader(&buf, order)
+		if r.BitOrder() != order {
+			t.Fatalf("unexpected order %v", r.BitOrder())
+		}
+
+		for i, n := range sizes {
+			if v, err := r.ReadBits(n); err != nil || v != vals[i] {
+				t.Fatalf("%v %d: expected %x but got %x (%v)", order, i, vals[i], v, err)
+			}
+
+			if v, err := r.ReadSignedBits(n%64 + 1); err != nil || v != signed[i] {
+				t.Fatalf("%v %d: expected %d but got %d (%v)", order, i, signed[i], v, err)
+			}
+		}
+
+		if v, err := r.ReadBits(2); err != nil || v != 1 && order == MSBFirst || v != 2 && order == LSBFirst {
+			t.Fatalf("expected false and true bit but got %b (%v)", v, err)
+		}
+
+		r.Align()
+
+		if !r.Aligned() {
+			t.Fatal("expected aligned reader")
+		}
+
+		if _, err := r.ReadBits(1); !errors.Is(err, io.EOF) {
+			t.Fatalf("expected EOF but got %v", err)
+		}
+	}
+}
+
+func TestBitReaderPeekSkip(t *testing.T) {
+	r := NewBitReaderBytes(src, MSBFirst)
+
+	if err := r.SkipBits(4); err != nil || r.Aligned() {
+		t.Fatalf("unexpected skip error %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if v, err := r.PeekBits(8); err != nil || v != 0x12 {
+			t.Fatalf("expected 12 but got %x (%v)", v, err)
+		}
+	}
+
+	r.Align()
+	r.Align()
+
+	if v, err := r.ReadBits(8); err != nil || v != 0x22 {
+		t.Fatalf("expected 22 but got %x (%v)", v, err)
+	}
+
+	if _, err := r.ReadBits(64); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected unexpected EOF but got %v", err)
+	}
+
+	if v, err := r.ReadBits(48); err != nil || v != 0x3344556677FF {
+		t.Fatalf("expected 3344556677FF but got %x (%v)", v, err)
+	}
+
+	r = NewBitReaderBytes(make([]byte, 100), LSBFirst)
+
+	if err := r.SkipBits(799); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.SkipBits(2); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected unexpected EOF but got %v", err)
+	}
+
+	if err := r.SkipBits(1); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF but got %v", err)
+	}
+}
+
+func TestBitErrors(t *testing.T) {
+	w := NewBitWriter(failingWriter{}, LSBFirst)
+
+	for _, err := range []error{w.WriteBits(3, 8), w.WriteSignedBits(3, 4), w.WriteSignedBits(3, -5)} {
+		if !errors.Is(err, ErrOverflow) {
+			t.Fatalf("expected overflow but got %v", err)
+		}
+	}
+
+	if err := w.WriteBits(3, 7); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Align(); !errors.Is(err, io.ErrClosedPipe) {
+		t.Fatalf("expected closed pipe but got %v", err)
+	}
+
+	r := NewBitReaderBytes(nil, MSBFirst)
+
+	for _, f := range []func(){
+		func() { _, _ = r.ReadBits(65) },
+		func() { _, _ = r.PeekBits(-1) },
+		func() { _, _ = r.ReadSignedBits(0) },
+		func() { _ = w.WriteBits(65, 0) },
+		func() { _ = w.WriteSignedBits(0, 0) },
+	} {
+		assertWidthPanics(t, 0, f)
+	}
+}
+
+func TestBitOrderString(t *testing.T) {
+	for order, expected := range map[BitOrder]string{
+		MSBFirst: "MSBFirst", LSBFirst: "LSBFirst", 7: "BitOrder(7)",
+	} {
+		if order.String() != expected {
+			t.Fatalf("expected %s but got %s", expected, order.String())
+		}
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// A Buffer owns a growing byte slice and appends encoded values in either little-endian or big-endian order, so
+// that variable length messages can be built without computing their size up front.
+type Buffer struct {
+	buf   []byte
+	order Order
+}
+
+// NewBuffer creates a Buffer which appends values in the given order to buf. buf may be nil or a slice with
+// some spare capacity, which is reused.
+func NewBuffer(buf []byte, order Order) *Buffer {
+	return &Buffer{buf: buf, order: order}
+}
+
+// NewLittleEndianBuffer creates a Buffer which appends little-endian values to buf. buf may be nil or a
+// slice with some spare capacity, which is reused.
+func NewLittleEndianBuffer(buf []byte) *Buffer {
+	return NewBuffer(buf, Little)
+}
+
+// NewBigEndianBuffer creates a Buffer which appends big-endian values to buf. buf may be nil or a
+// slice with some spare capacity, which is reused.
+func NewBigEndianBuffer(buf []byte) *Buffer {
+	return NewBuffer(buf, Big)
+}
+
+// Order returns the byte order used for encoding.
+func (b *Buffer) Order() Order {
+	return b.order
+}
+
+// Bytes returns the appended bytes. The slice is only valid until the next modification of the Buffer.
+func (b *Buffer) Bytes() []byte {
+	return b.buf
+}
+
+// Len returns the amount of appended bytes.
+func (b *Buffer) Len() int {
+	return len(b.buf)
+}
+
+// Reset discards all appended bytes but keeps the allocated memory for reuse.
+func (b *Buffer) Reset() {
+	b.buf = b.buf[:0]
+}
+
+// Grow ensures that at least another n bytes can be appended without a reallocation. Panics when n < 0.
+func (b *Buffer) Grow(n int) {
+	if n < 0 {
+		panic("byteorder.Buffer.Grow: negative count")
+	}
+
+	if cap(b.buf)-len(b.buf) < n {
+		tmp := make([]byte, len(b.buf), 2*cap(b.buf)+n)
+		copy(tmp, b.buf)
+		b.buf = tmp
+	}
+}
+
+// AppendUint16 appends 2 bytes.
+func (b *Buffer) AppendUint16(v uint16) {
+	b.buf = b.order.AppendUint16(b.buf, v)
+}
+
+// AppendUint24 appends 3 bytes.
+func (b *Buffer) AppendUint24(v uint32) {
+	b.buf = b.order.AppendUint24(b.buf, v)
+}
+
+// AppendUint32 appends 4 bytes.
+func (b *Buffer) AppendUint32(v uint32) {
+	b.buf = b.order.AppendUint32(b.buf, v)
+}
+
+// AppendUint40 appends 5 bytes.
+func (b *Buffer) AppendUint40(v uint64) {
+	b.buf = b.order.AppendUint40(b.buf, v)
+}
+
+// AppendUint48 appends 6 bytes.
+func (b *Buffer) AppendUint48(v uint64) {
+	b.buf = b.order.AppendUint48(b.buf, v)
+}
+
+// AppendUint56 appends 7 bytes.
+func (b *Buffer) AppendUint56(v uint64) {
+	b.buf = b.order.AppendUint56(b.buf, v)
+}
+
+// AppendUint64 appends 8 bytes.
+func (b *Buffer) AppendUint64(v uint64) {
+	b.buf = b.order.AppendUint64(b.buf, v)
+}
+
+// AppendInt16 appends 2 bytes.
+func (b *Buffer) AppendInt16(v int16) {
+	b.buf = b.order.AppendInt16(b.buf, v)
+}
+
+// AppendInt24 appends 3 bytes. Panics if v is not within [MinInt24, MaxInt24].
+func (b *Buffer) AppendInt24(v int32) {
+	b.buf = b.order.AppendInt24(b.buf, v)
+}
+
+// AppendInt32 appends 4 bytes.
+func (b *Buffer) AppendInt32(v int32) {
+	b.buf = b.order.AppendInt32(b.buf, v)
+}
+
+// AppendInt40 appends 5 bytes. Panics if v is not within [MinInt40, MaxInt40].
+func (b *Buffer) AppendInt40(v int64) {
+	b.buf = b.order.AppendInt40(b.buf, v)
+}
+
+// AppendInt48 appends 6 bytes. Panics if v is not within [MinInt48, MaxInt48].
+func (b *Buffer) AppendInt48(v int64) {
+	b.buf = b.order.AppendInt48(b.buf, v)
+}
+
+// AppendInt56 appends 7 bytes. Panics if v is not within [MinInt56, MaxInt56].
+func (b *Buffer) AppendInt56(v int64) {
+	b.buf = b.order.AppendInt56(b.buf, v)
+}
+
+// AppendInt64 appends 8 bytes.
+func (b *Buffer) AppendInt64(v int64) {
+	b.buf = b.order.AppendInt64(b.buf, v)
+}
+
+// AppendFloat32 appends 4 bytes.
+func (b *Buffer) AppendFloat32(v float32) {
+	b.buf = b.order.AppendFloat32(b.buf, v)
+}
+
+// AppendFloat64 appends 8 bytes.
+func (b *Buffer) AppendFloat64(v float64) {
+	b.buf = b.order.AppendFloat64(b.buf, v)
+}
+
+// AppendUvarint appends the unsigned LEB128 encoding of v.
+func (b *Buffer) AppendUvarint(v uint64) {
+	b.buf = AppendUvarint(b.buf, v)
+}
+
+// AppendVarint appends the signed LEB128 encoding of v.
+func (b *Buffer) AppendVarint(v int64) {
+	b.buf = AppendVarint(b.buf, v)
+}
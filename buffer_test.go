@@ -0,0 +1,117 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestBuffer(t *testing.T) {
+	tests := []struct {
+		buf *Buffer
+		r   func([]byte) *Reader
+	}{
+		{NewLittleEndianBuffer(nil), NewLittleEndianReader},
+		{NewBigEndianBuffer(make([]byte, 0, 4)), NewBigEndianReader},
+	}
+
+	for _, tt := range tests {
+		b := tt.buf
+		b.AppendUint16(1)
+		b.AppendUint24(2)
+		b.AppendUint32(3)
+		b.AppendUint40(4)
+		b.AppendUint48(5)
+		b.AppendUint56(6)
+		b.AppendUint64(7)
+		b.AppendInt16(-1)
+		b.AppendInt24(-2)
+		b.AppendInt32(-3)
+		b.AppendInt40(-4)
+		b.AppendInt48(-5)
+		b.AppendInt56(-6)
+		b.AppendInt64(-7)
+		b.AppendFloat32(8)
+		b.AppendFloat64(9)
+
+		if b.Len() != 2*35+12 {
+			t.Fatalf("unexpected length %d", b.Len())
+		}
+
+		r := tt.r(b.Bytes())
+		if r.ReadUint16() != 1 || r.ReadUint24() != 2 || r.ReadUint32() != 3 || r.ReadUint40() != 4 ||
+			r.ReadUint48() != 5 || r.ReadUint56() != 6 || r.ReadUint64() != 7 {
+			t.Fatalf("unexpected unsigned values")
+		}
+
+		if r.ReadInt16() != -1 || r.ReadInt24() != -2 || r.ReadInt32() != -3 || r.ReadInt40() != -4 ||
+			r.ReadInt48() != -5 || r.ReadInt56() != -6 || r.ReadInt64() != -7 {
+			t.Fatalf("unexpected signed values")
+		}
+
+		if r.ReadFloat32() != 8 || r.ReadFloat64() != 9 {
+			t.Fatalf("unexpected float values")
+		}
+	}
+}
+
+func TestBufferReset(t *testing.T) {
+	b := NewLittleEndianBuffer(nil)
+	b.Grow(16)
+	b.AppendUint32(0x44332211)
+	p := &b.Bytes()[0]
+
+	if !bytes.Equal(b.Bytes(), src[:4]) {
+		t.Fatalf("unexpected bytes %x", b.Bytes())
+	}
+
+	b.Reset()
+
+	if b.Len() != 0 {
+		t.Fatalf("expected empty buffer")
+	}
+
+	b.AppendUint16(1)
+
+	if p != &b.Bytes()[0] {
+		t.Fatalf("expected memory to be reused")
+	}
+}
+
+func TestBufferOverflow(t *testing.T) {
+	b := NewBigEndianBuffer(nil)
+
+	assertOverflow(t, func() { b.AppendInt24(MaxInt24 + 1) })
+	assertOverflow(t, func() { b.AppendInt40(MaxInt40 + 1) })
+	assertOverflow(t, func() { b.AppendInt48(MaxInt48 + 1) })
+	assertOverflow(t, func() { b.AppendInt56(MaxInt56 + 1) })
+
+	if b.Len() != 0 {
+		t.Fatalf("expected that nothing has been appended")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+
+	b.Grow(-1)
+}
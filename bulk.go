@@ -0,0 +1,775 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// memOf returns the memory of the slice pointed to by sp as a byte slice, which is only valid as long as the
+// original slice is alive. The size of a single element must be given.
+func memOf(sp unsafe.Pointer, size int) []byte {
+	var b []byte
+
+	src := (*reflect.SliceHeader)(sp)
+	dst := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+	dst.Data = src.Data
+	dst.Len = src.Len * size
+	dst.Cap = src.Len * size
+
+	return b
+}
+
+// mustFit panics with a ShortBufferError, if available < needed.
+func mustFit(needed, available int) {
+	if err := shortBuffer(needed, available); err != nil {
+		panic(err)
+	}
+}
+
+// ReadUint16s decodes len(dst) values of 2 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 2*len(dst).
+func (o Order) ReadUint16s(dst []uint16, src []byte) {
+	mustFit(2*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 2), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadUint16(src[2*i:])
+	}
+}
+
+// WriteUint16s encodes all values from src into dst using 2 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 2*len(src).
+func (o Order) WriteUint16s(dst []byte, src []uint16) {
+	mustFit(2*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 2))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteUint16(dst[2*i:], v)
+	}
+}
+
+// ReadUint24s decodes len(dst) values of 3 bytes each from src. Panics when len(src) < 3*len(dst).
+func (o Order) ReadUint24s(dst []uint32, src []byte) {
+	mustFit(3*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadUint24(src[3*i:])
+	}
+}
+
+// WriteUint24s encodes all values from src into dst using 3 bytes each. Panics when len(dst) < 3*len(src).
+func (o Order) WriteUint24s(dst []byte, src []uint32) {
+	mustFit(3*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteUint24(dst[3*i:], v)
+	}
+}
+
+// ReadUint32s decodes len(dst) values of 4 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 4*len(dst).
+func (o Order) ReadUint32s(dst []uint32, src []byte) {
+	mustFit(4*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 4), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadUint32(src[4*i:])
+	}
+}
+
+// WriteUint32s encodes all values from src into dst using 4 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 4*len(src).
+func (o Order) WriteUint32s(dst []byte, src []uint32) {
+	mustFit(4*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 4))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteUint32(dst[4*i:], v)
+	}
+}
+
+// ReadUint40s decodes len(dst) values of 5 bytes each from src. Panics when len(src) < 5*len(dst).
+func (o Order) ReadUint40s(dst []uint64, src []byte) {
+	mustFit(5*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadUint40(src[5*i:])
+	}
+}
+
+// WriteUint40s encodes all values from src into dst using 5 bytes each. Panics when len(dst) < 5*len(src).
+func (o Order) WriteUint40s(dst []byte, src []uint64) {
+	mustFit(5*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteUint40(dst[5*i:], v)
+	}
+}
+
+// ReadUint48s decodes len(dst) values of 6 bytes each from src. Panics when len(src) < 6*len(dst).
+func (o Order) ReadUint48s(dst []uint64, src []byte) {
+	mustFit(6*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadUint48(src[6*i:])
+	}
+}
+
+// WriteUint48s encodes all values from src into dst using 6 bytes each. Panics when len(dst) < 6*len(src).
+func (o Order) WriteUint48s(dst []byte, src []uint64) {
+	mustFit(6*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteUint48(dst[6*i:], v)
+	}
+}
+
+// ReadUint56s decodes len(dst) values of 7 bytes each from src. Panics when len(src) < 7*len(dst).
+func (o Order) ReadUint56s(dst []uint64, src []byte) {
+	mustFit(7*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadUint56(src[7*i:])
+	}
+}
+
+// WriteUint56s encodes all values from src into dst using 7 bytes each. Panics when len(dst) < 7*len(src).
+func (o Order) WriteUint56s(dst []byte, src []uint64) {
+	mustFit(7*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteUint56(dst[7*i:], v)
+	}
+}
+
+// ReadUint64s decodes len(dst) values of 8 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 8*len(dst).
+func (o Order) ReadUint64s(dst []uint64, src []byte) {
+	mustFit(8*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 8), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadUint64(src[8*i:])
+	}
+}
+
+// WriteUint64s encodes all values from src into dst using 8 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 8*len(src).
+func (o Order) WriteUint64s(dst []byte, src []uint64) {
+	mustFit(8*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 8))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteUint64(dst[8*i:], v)
+	}
+}
+
+// ReadInt16s decodes len(dst) values of 2 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 2*len(dst).
+func (o Order) ReadInt16s(dst []int16, src []byte) {
+	mustFit(2*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 2), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadInt16(src[2*i:])
+	}
+}
+
+// WriteInt16s encodes all values from src into dst using 2 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 2*len(src).
+func (o Order) WriteInt16s(dst []byte, src []int16) {
+	mustFit(2*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 2))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteInt16(dst[2*i:], v)
+	}
+}
+
+// ReadInt24s decodes len(dst) values of 3 bytes each from src. Panics when len(src) < 3*len(dst).
+func (o Order) ReadInt24s(dst []int32, src []byte) {
+	mustFit(3*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadInt24(src[3*i:])
+	}
+}
+
+// WriteInt24s encodes all values from src into dst using 3 bytes each. Panics when len(dst) < 3*len(src) or
+// if a value is not within [MinInt24, MaxInt24], in which case dst is only partially written.
+func (o Order) WriteInt24s(dst []byte, src []int32) {
+	mustFit(3*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteInt24(dst[3*i:], v)
+	}
+}
+
+// ReadInt32s decodes len(dst) values of 4 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 4*len(dst).
+func (o Order) ReadInt32s(dst []int32, src []byte) {
+	mustFit(4*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 4), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadInt32(src[4*i:])
+	}
+}
+
+// WriteInt32s encodes all values from src into dst using 4 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 4*len(src).
+func (o Order) WriteInt32s(dst []byte, src []int32) {
+	mustFit(4*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 4))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteInt32(dst[4*i:], v)
+	}
+}
+
+// ReadInt40s decodes len(dst) values of 5 bytes each from src. Panics when len(src) < 5*len(dst).
+func (o Order) ReadInt40s(dst []int64, src []byte) {
+	mustFit(5*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadInt40(src[5*i:])
+	}
+}
+
+// WriteInt40s encodes all values from src into dst using 5 bytes each. Panics when len(dst) < 5*len(src) or
+// if a value is not within [MinInt40, MaxInt40], in which case dst is only partially written.
+func (o Order) WriteInt40s(dst []byte, src []int64) {
+	mustFit(5*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteInt40(dst[5*i:], v)
+	}
+}
+
+// ReadInt48s decodes len(dst) values of 6 bytes each from src. Panics when len(src) < 6*len(dst).
+func (o Order) ReadInt48s(dst []int64, src []byte) {
+	mustFit(6*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadInt48(src[6*i:])
+	}
+}
+
+// WriteInt48s encodes all values from src into dst using 6 bytes each. Panics when len(dst) < 6*len(src) or
+// if a value is not within [MinInt48, MaxInt48], in which case dst is only partially written.
+func (o Order) WriteInt48s(dst []byte, src []int64) {
+	mustFit(6*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteInt48(dst[6*i:], v)
+	}
+}
+
+// ReadInt56s decodes len(dst) values of 7 bytes each from src. Panics when len(src) < 7*len(dst).
+func (o Order) ReadInt56s(dst []int64, src []byte) {
+	mustFit(7*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = o.ReadInt56(src[7*i:])
+	}
+}
+
+// WriteInt56s encodes all values from src into dst using 7 bytes each. Panics when len(dst) < 7*len(src) or
+// if a value is not within [MinInt56, MaxInt56], in which case dst is only partially written.
+func (o Order) WriteInt56s(dst []byte, src []int64) {
+	mustFit(7*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteInt56(dst[7*i:], v)
+	}
+}
+
+// ReadInt64s decodes len(dst) values of 8 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 8*len(dst).
+func (o Order) ReadInt64s(dst []int64, src []byte) {
+	mustFit(8*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 8), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadInt64(src[8*i:])
+	}
+}
+
+// WriteInt64s encodes all values from src into dst using 8 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 8*len(src).
+func (o Order) WriteInt64s(dst []byte, src []int64) {
+	mustFit(8*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 8))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteInt64(dst[8*i:], v)
+	}
+}
+
+// ReadFloat32s decodes len(dst) values of 4 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 4*len(dst).
+func (o Order) ReadFloat32s(dst []float32, src []byte) {
+	mustFit(4*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 4), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadFloat32(src[4*i:])
+	}
+}
+
+// WriteFloat32s encodes all values from src into dst using 4 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 4*len(src).
+func (o Order) WriteFloat32s(dst []byte, src []float32) {
+	mustFit(4*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 4))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteFloat32(dst[4*i:], v)
+	}
+}
+
+// ReadFloat64s decodes len(dst) values of 8 bytes each from src. If o equals Native, the bytes are just copied.
+// Panics when len(src) < 8*len(dst).
+func (o Order) ReadFloat64s(dst []float64, src []byte) {
+	mustFit(8*len(dst), len(src))
+
+	if o == Native {
+		copy(memOf(unsafe.Pointer(&dst), 8), src)
+
+		return
+	}
+
+	for i := range dst {
+		dst[i] = o.ReadFloat64(src[8*i:])
+	}
+}
+
+// WriteFloat64s encodes all values from src into dst using 8 bytes each. If o equals Native, the bytes are just
+// copied. Panics when len(dst) < 8*len(src).
+func (o Order) WriteFloat64s(dst []byte, src []float64) {
+	mustFit(8*len(src), len(dst))
+
+	if o == Native {
+		copy(dst, memOf(unsafe.Pointer(&src), 8))
+
+		return
+	}
+
+	for i, v := range src {
+		o.WriteFloat64(dst[8*i:], v)
+	}
+}
+
+// ReadUint16s decodes len(dst) values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b LittleEndian) ReadUint16s(dst []uint16) {
+	Little.ReadUint16s(dst, b)
+}
+
+// WriteUint16s encodes all values from src using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b LittleEndian) WriteUint16s(src []uint16) {
+	Little.WriteUint16s(b, src)
+}
+
+// ReadUint24s decodes len(dst) values of 3 bytes each. Panics when len(b) < 3*len(dst).
+func (b LittleEndian) ReadUint24s(dst []uint32) {
+	Little.ReadUint24s(dst, b)
+}
+
+// WriteUint24s encodes all values from src using 3 bytes each. Panics when len(b) < 3*len(src).
+func (b LittleEndian) WriteUint24s(src []uint32) {
+	Little.WriteUint24s(b, src)
+}
+
+// ReadUint32s decodes len(dst) values of 4 bytes each. Panics when len(b) < 4*len(dst).
+func (b LittleEndian) ReadUint32s(dst []uint32) {
+	Little.ReadUint32s(dst, b)
+}
+
+// WriteUint32s encodes all values from src using 4 bytes each. Panics when len(b) < 4*len(src).
+func (b LittleEndian) WriteUint32s(src []uint32) {
+	Little.WriteUint32s(b, src)
+}
+
+// ReadUint40s decodes len(dst) values of 5 bytes each. Panics when len(b) < 5*len(dst).
+func (b LittleEndian) ReadUint40s(dst []uint64) {
+	Little.ReadUint40s(dst, b)
+}
+
+// WriteUint40s encodes all values from src using 5 bytes each. Panics when len(b) < 5*len(src).
+func (b LittleEndian) WriteUint40s(src []uint64) {
+	Little.WriteUint40s(b, src)
+}
+
+// ReadUint48s decodes len(dst) values of 6 bytes each. Panics when len(b) < 6*len(dst).
+func (b LittleEndian) ReadUint48s(dst []uint64) {
+	Little.ReadUint48s(dst, b)
+}
+
+// WriteUint48s encodes all values from src using 6 bytes each. Panics when len(b) < 6*len(src).
+func (b LittleEndian) WriteUint48s(src []uint64) {
+	Little.WriteUint48s(b, src)
+}
+
+// ReadUint56s decodes len(dst) values of 7 bytes each. Panics when len(b) < 7*len(dst).
+func (b LittleEndian) ReadUint56s(dst []uint64) {
+	Little.ReadUint56s(dst, b)
+}
+
+// WriteUint56s encodes all values from src using 7 bytes each. Panics when len(b) < 7*len(src).
+func (b LittleEndian) WriteUint56s(src []uint64) {
+	Little.WriteUint56s(b, src)
+}
+
+// ReadUint64s decodes len(dst) values of 8 bytes each. Panics when len(b) < 8*len(dst).
+func (b LittleEndian) ReadUint64s(dst []uint64) {
+	Little.ReadUint64s(dst, b)
+}
+
+// WriteUint64s encodes all values from src using 8 bytes each. Panics when len(b) < 8*len(src).
+func (b LittleEndian) WriteUint64s(src []uint64) {
+	Little.WriteUint64s(b, src)
+}
+
+// ReadInt16s decodes len(dst) values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b LittleEndian) ReadInt16s(dst []int16) {
+	Little.ReadInt16s(dst, b)
+}
+
+// WriteInt16s encodes all values from src using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b LittleEndian) WriteInt16s(src []int16) {
+	Little.WriteInt16s(b, src)
+}
+
+// ReadInt24s decodes len(dst) values of 3 bytes each. Panics when len(b) < 3*len(dst).
+func (b LittleEndian) ReadInt24s(dst []int32) {
+	Little.ReadInt24s(dst, b)
+}
+
+// WriteInt24s encodes all values from src using 3 bytes each. Panics when len(b) < 3*len(src) or if a value is
+// not within [MinInt24, MaxInt24].
+func (b LittleEndian) WriteInt24s(src []int32) {
+	Little.WriteInt24s(b, src)
+}
+
+// ReadInt32s decodes len(dst) values of 4 bytes each. Panics when len(b) < 4*len(dst).
+func (b LittleEndian) ReadInt32s(dst []int32) {
+	Little.ReadInt32s(dst, b)
+}
+
+// WriteInt32s encodes all values from src using 4 bytes each. Panics when len(b) < 4*len(src).
+func (b LittleEndian) WriteInt32s(src []int32) {
+	Little.WriteInt32s(b, src)
+}
+
+// ReadInt40s decodes len(dst) values of 5 bytes each. Panics when len(b) < 5*len(dst).
+func (b LittleEndian) ReadInt40s(dst []int64) {
+	Little.ReadInt40s(dst, b)
+}
+
+// WriteInt40s encodes all values from src using 5 bytes each. Panics when len(b) < 5*len(src) or if a value is
+// not within [MinInt40, MaxInt40].
+func (b LittleEndian) WriteInt40s(src []int64) {
+	Little.WriteInt40s(b, src)
+}
+
+// ReadInt48s decodes len(dst) values of 6 bytes each. Panics when len(b) < 6*len(dst).
+func (b LittleEndian) ReadInt48s(dst []int64) {
+	Little.ReadInt48s(dst, b)
+}
+
+// WriteInt48s encodes all values from src using 6 bytes each. Panics when len(b) < 6*len(src) or if a value is
+// not within [MinInt48, MaxInt48].
+func (b LittleEndian) WriteInt48s(src []int64) {
+	Little.WriteInt48s(b, src)
+}
+
+// ReadInt56s decodes len(dst) values of 7 bytes each. Panics when len(b) < 7*len(dst).
+func (b LittleEndian) ReadInt56s(dst []int64) {
+	Little.ReadInt56s(dst, b)
+}
+
+// WriteInt56s encodes all values from src using 7 bytes each. Panics when len(b) < 7*len(src) or if a value is
+// not within [MinInt56, MaxInt56].
+func (b LittleEndian) WriteInt56s(src []int64) {
+	Little.WriteInt56s(b, src)
+}
+
+// ReadInt64s decodes len(dst) values of 8 bytes each. Panics when len(b) < 8*len(dst).
+func (b LittleEndian) ReadInt64s(dst []int64) {
+	Little.ReadInt64s(dst, b)
+}
+
+// WriteInt64s encodes all values from src using 8 bytes each. Panics when len(b) < 8*len(src).
+func (b LittleEndian) WriteInt64s(src []int64) {
+	Little.WriteInt64s(b, src)
+}
+
+// ReadFloat32s decodes len(dst) values of 4 bytes each. Panics when len(b) < 4*len(dst).
+func (b LittleEndian) ReadFloat32s(dst []float32) {
+	Little.ReadFloat32s(dst, b)
+}
+
+// WriteFloat32s encodes all values from src using 4 bytes each. Panics when len(b) < 4*len(src).
+func (b LittleEndian) WriteFloat32s(src []float32) {
+	Little.WriteFloat32s(b, src)
+}
+
+// ReadFloat64s decodes len(dst) values of 8 bytes each. Panics when len(b) < 8*len(dst).
+func (b LittleEndian) ReadFloat64s(dst []float64) {
+	Little.ReadFloat64s(dst, b)
+}
+
+// WriteFloat64s encodes all values from src using 8 bytes each. Panics when len(b) < 8*len(src).
+func (b LittleEndian) WriteFloat64s(src []float64) {
+	Little.WriteFloat64s(b, src)
+}
+
+// ReadUint16s decodes len(dst) values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b BigEndian) ReadUint16s(dst []uint16) {
+	Big.ReadUint16s(dst, b)
+}
+
+// WriteUint16s encodes all values from src using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b BigEndian) WriteUint16s(src []uint16) {
+	Big.WriteUint16s(b, src)
+}
+
+// ReadUint24s decodes len(dst) values of 3 bytes each. Panics when len(b) < 3*len(dst).
+func (b BigEndian) ReadUint24s(dst []uint32) {
+	Big.ReadUint24s(dst, b)
+}
+
+// WriteUint24s encodes all values from src using 3 bytes each. Panics when len(b) < 3*len(src).
+func (b BigEndian) WriteUint24s(src []uint32) {
+	Big.WriteUint24s(b, src)
+}
+
+// ReadUint32s decodes len(dst) values of 4 bytes each. Panics when len(b) < 4*len(dst).
+func (b BigEndian) ReadUint32s(dst []uint32) {
+	Big.ReadUint32s(dst, b)
+}
+
+// WriteUint32s encodes all values from src using 4 bytes each. Panics when len(b) < 4*len(src).
+func (b BigEndian) WriteUint32s(src []uint32) {
+	Big.WriteUint32s(b, src)
+}
+
+// ReadUint40s decodes len(dst) values of 5 bytes each. Panics when len(b) < 5*len(dst).
+func (b BigEndian) ReadUint40s(dst []uint64) {
+	Big.ReadUint40s(dst, b)
+}
+
+// WriteUint40s encodes all values from src using 5 bytes each. Panics when len(b) < 5*len(src).
+func (b BigEndian) WriteUint40s(src []uint64) {
+	Big.WriteUint40s(b, src)
+}
+
+// ReadUint48s decodes len(dst) values of 6 bytes each. Panics when len(b) < 6*len(dst).
+func (b BigEndian) ReadUint48s(dst []uint64) {
+	Big.ReadUint48s(dst, b)
+}
+
+// WriteUint48s encodes all values from src using 6 bytes each. Panics when len(b) < 6*len(src).
+func (b BigEndian) WriteUint48s(src []uint64) {
+	Big.WriteUint48s(b, src)
+}
+
+// ReadUint56s decodes len(dst) values of 7 bytes each. Panics when len(b) < 7*len(dst).
+func (b BigEndian) ReadUint56s(dst []uint64) {
+	Big.ReadUint56s(dst, b)
+}
+
+// WriteUint56s encodes all values from src using 7 bytes each. Panics when len(b) < 7*len(src).
+func (b BigEndian) WriteUint56s(src []uint64) {
+	Big.WriteUint56s(b, src)
+}
+
+// ReadUint64s decodes len(dst) values of 8 bytes each. Panics when len(b) < 8*len(dst).
+func (b BigEndian) ReadUint64s(dst []uint64) {
+	Big.ReadUint64s(dst, b)
+}
+
+// WriteUint64s encodes all values from src using 8 bytes each. Panics when len(b) < 8*len(src).
+func (b BigEndian) WriteUint64s(src []uint64) {
+	Big.WriteUint64s(b, src)
+}
+
+// ReadInt16s decodes len(dst) values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b BigEndian) ReadInt16s(dst []int16) {
+	Big.ReadInt16s(dst, b)
+}
+
+// WriteInt16s encodes all values from src using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b BigEndian) WriteInt16s(src []int16) {
+	Big.WriteInt16s(b, src)
+}
+
+// ReadInt24s decodes len(dst) values of 3 bytes each. Panics when len(b) < 3*len(dst).
+func (b BigEndian) ReadInt24s(dst []int32) {
+	Big.ReadInt24s(dst, b)
+}
+
+// WriteInt24s encodes all values from src using 3 bytes each. Panics when len(b) < 3*len(src) or if a value is
+// not within [MinInt24, MaxInt24].
+func (b BigEndian) WriteInt24s(src []int32) {
+	Big.WriteInt24s(b, src)
+}
+
+// ReadInt32s decodes len(dst) values of 4 bytes each. Panics when len(b) < 4*len(dst).
+func (b BigEndian) ReadInt32s(dst []int32) {
+	Big.ReadInt32s(dst, b)
+}
+
+// WriteInt32s encodes all values from src using 4 bytes each. Panics when len(b) < 4*len(src).
+func (b BigEndian) WriteInt32s(src []int32) {
+	Big.WriteInt32s(b, src)
+}
+
+// ReadInt40s decodes len(dst) values of 5 bytes each. Panics when len(b) < 5*len(dst).
+func (b BigEndian) ReadInt40s(dst []int64) {
+	Big.ReadInt40s(dst, b)
+}
+
+// WriteInt40s encodes all values from src using 5 bytes each. Panics when len(b) < 5*len(src) or if a value is
+// not within [MinInt40, MaxInt40].
+func (b BigEndian) WriteInt40s(src []int64) {
+	Big.WriteInt40s(b, src)
+}
+
+// ReadInt48s decodes len(dst) values of 6 bytes each. Panics when len(b) < 6*len(dst).
+func (b BigEndian) ReadInt48s(dst []int64) {
+	Big.ReadInt48s(dst, b)
+}
+
+// WriteInt48s encodes all values from src using 6 bytes each. Panics when len(b) < 6*len(src) or if a value is
+// not within [MinInt48, MaxInt48].
+func (b BigEndian) WriteInt48s(src []int64) {
+	Big.WriteInt48s(b, src)
+}
+
+// ReadInt56s decodes len(dst) values of 7 bytes each. Panics when len(b) < 7*len(dst).
+func (b BigEndian) ReadInt56s(dst []int64) {
+	Big.ReadInt56s(dst, b)
+}
+
+// WriteInt56s encodes all values from src using 7 bytes each. Panics when len(b) < 7*len(src) or if a value is
+// not within [MinInt56, MaxInt56].
+func (b BigEndian) WriteInt56s(src []int64) {
+	Big.WriteInt56s(b, src)
+}
+
+// ReadInt64s decodes len(dst) values of 8 bytes each. Panics when len(b) < 8*len(dst).
+func (b BigEndian) ReadInt64s(dst []int64) {
+	Big.ReadInt64s(dst, b)
+}
+
+// WriteInt64s encodes all values from src using 8 bytes each. Panics when len(b) < 8*len(src).
+func (b BigEndian) WriteInt64s(src []int64) {
+	Big.WriteInt64s(b, src)
+}
+
+// ReadFloat32s decodes len(dst) values of 4 bytes each. Panics when len(b) < 4*len(dst).
+func (b BigEndian) ReadFloat32s(dst []float32) {
+	Big.ReadFloat32s(dst, b)
+}
+
+// WriteFloat32s encodes all values from src using 4 bytes each. Panics when len(b) < 4*len(src).
+func (b BigEndian) WriteFloat32s(src []float32) {
+	Big.WriteFloat32s(b, src)
+}
+
+// ReadFloat64s decodes len(dst) values of 8 bytes each. Panics when len(b) < 8*len(dst).
+func (b BigEndian) ReadFloat64s(dst []float64) {
+	Big.ReadFloat64s(dst, b)
+}
+
+// WriteFloat64s encodes all values from src using 8 bytes each. Panics when len(b) < 8*len(src).
+func (b BigEndian) WriteFloat64s(src []float64) {
+	Big.WriteFloat64s(b, src)
+}
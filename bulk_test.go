@@ -0,0 +1,221 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestBulk(t *testing.T) {
+	data := bytes.Repeat(src, 3)
+
+	for _, tt := range []struct {
+		size int
+		// copyValues decodes 3 values from src and encodes them little-endian to the start of dst and big-endian
+		// behind. Returns true if the last decoded values equal a single read.
+		copyValues func(dst, src []byte) bool
+	}{
+		{2, func(dst, src []byte) bool {
+			le, be := make([]uint16, 3), make([]uint16, 3)
+			LE(src).ReadUint16s(le)
+			BE(src).ReadUint16s(be)
+			LE(dst).WriteUint16s(le)
+			BE(dst[len(src):]).WriteUint16s(be)
+
+			return le[2] == LE(src[4:]).ReadUint16() && be[2] == BE(src[4:]).ReadUint16()
+		}},
+		{3, func(dst, src []byte) bool {
+			le, be := make([]uint32, 3), make([]uint32, 3)
+			LE(src).ReadUint24s(le)
+			BE(src).ReadUint24s(be)
+			LE(dst).WriteUint24s(le)
+			BE(dst[len(src):]).WriteUint24s(be)
+
+			return le[2] == LE(src[6:]).ReadUint24() && be[2] == BE(src[6:]).ReadUint24()
+		}},
+		{4, func(dst, src []byte) bool {
+			le, be := make([]uint32, 3), make([]uint32, 3)
+			LE(src).ReadUint32s(le)
+			BE(src).ReadUint32s(be)
+			LE(dst).WriteUint32s(le)
+			BE(dst[len(src):]).WriteUint32s(be)
+
+			return le[2] == LE(src[8:]).ReadUint32() && be[2] == BE(src[8:]).ReadUint32()
+		}},
+		{5, func(dst, src []byte) bool {
+			le, be := make([]uint64, 3), make([]uint64, 3)
+			LE(src).ReadUint40s(le)
+			BE(src).ReadUint40s(be)
+			LE(dst).WriteUint40s(le)
+			BE(dst[len(src):]).WriteUint40s(be)
+
+			return le[2] == LE(src[10:]).ReadUint40() && be[2] == BE(src[10:]).ReadUint40()
+		}},
+		{6, func(dst, src []byte) bool {
+			le, be := make([]uint64, 3), make([]uint64, 3)
+			LE(src).ReadUint48s(le)
+			BE(src).ReadUint48s(be)
+			LE(dst).WriteUint48s(le)
+			BE(dst[len(src):]).WriteUint48s(be)
+
+			return le[2] == LE(src[12:]).ReadUint48() && be[2] == BE(src[12:]).ReadUint48()
+		}},
+		{7, func(dst, src []byte) bool {
+			le, be := make([]uint64, 3), make([]uint64, 3)
+			LE(src).ReadUint56s(le)
+			BE(src).ReadUint56s(be)
+			LE(dst).WriteUint56s(le)
+			BE(dst[len(src):]).WriteUint56s(be)
+
+			return le[2] == LE(src[14:]).ReadUint56() && be[2] == BE(src[14:]).ReadUint56()
+		}},
+		{8, func(dst, src []byte) bool {
+			le, be := make([]uint64, 3), make([]uint64, 3)
+			LE(src).ReadUint64s(le)
+			BE(src).ReadUint64s(be)
+			LE(dst).WriteUint64s(le)
+			BE(dst[len(src):]).WriteUint64s(be)
+
+			return le[2] == LE(src[16:]).ReadUint64() && be[2] == BE(src[16:]).ReadUint64()
+		}},
+		{2, func(dst, src []byte) bool {
+			le, be := make([]int16, 3), make([]int16, 3)
+			LE(src).ReadInt16s(le)
+			BE(src).ReadInt16s(be)
+			LE(dst).WriteInt16s(le)
+			BE(dst[len(src):]).WriteInt16s(be)
+
+			return le[2] == LE(src[4:]).ReadInt16() && be[2] == BE(src[4:]).ReadInt16()
+		}},
+		{3, func(dst, src []byte) bool {
+			le, be := make([]int32, 3), make([]int32, 3)
+			LE(src).ReadInt24s(le)
+			BE(src).ReadInt24s(be)
+			LE(dst).WriteInt24s(le)
+			BE(dst[len(src):]).WriteInt24s(be)
+
+			return le[2] == LE(src[6:]).ReadInt24() && be[2] == BE(src[6:]).ReadInt24()
+		}},
+		{4, func(dst, src []byte) bool {
+			le, be := make([]int32, 3), make([]int32, 3)
+			LE(src).ReadInt32s(le)
+			BE(src).ReadInt32s(be)
+			LE(dst).WriteInt32s(le)
+			BE(dst[len(src):]).WriteInt32s(be)
+
+			return le[2] == LE(src[8:]).ReadInt32() && be[2] == BE(src[8:]).ReadInt32()
+		}},
+		{5, func(dst, src []byte) bool {
+			le, be := make([]int64, 3), make([]int64, 3)
+			LE(src).ReadInt40s(le)
+			BE(src).ReadInt40s(be)
+			LE(dst).WriteInt40s(le)
+			BE(dst[len(src):]).WriteInt40s(be)
+
+			return le[2] == LE(src[10:]).ReadInt40() && be[2] == BE(src[10:]).ReadInt40()
+		}},
+		{6, func(dst, src []byte) bool {
+			le, be := make([]int64, 3), make([]int64, 3)
+			LE(src).ReadInt48s(le)
+			BE(src).ReadInt48s(be)
+			LE(dst).WriteInt48s(le)
+			BE(dst[len(src):]).WriteInt48s(be)
+
+			return le[2] == LE(src[12:]).ReadInt48() && be[2] == BE(src[12:]).ReadInt48()
+		}},
+		{7, func(dst, src []byte) bool {
+			le, be := make([]int64, 3), make([]int64, 3)
+			LE(src).ReadInt56s(le)
+			BE(src).ReadInt56s(be)
+			LE(dst).WriteInt56s(le)
+			BE(dst[len(src):]).WriteInt56s(be)
+
+			return le[2] == LE(src[14:]).ReadInt56() && be[2] == BE(src[14:]).ReadInt56()
+		}},
+		{8, func(dst, src []byte) bool {
+			le, be := make([]int64, 3), make([]int64, 3)
+			LE(src).ReadInt64s(le)
+			BE(src).ReadInt64s(be)
+			LE(dst).WriteInt64s(le)
+			BE(dst[len(src):]).WriteInt64s(be)
+
+			return le[2] == LE(src[16:]).ReadInt64() && be[2] == BE(src[16:]).ReadInt64()
+		}},
+		{4, func(dst, src []byte) bool {
+			le, be := make([]float32, 3), make([]float32, 3)
+			LE(src).ReadFloat32s(le)
+			BE(src).ReadFloat32s(be)
+			LE(dst).WriteFloat32s(le)
+			BE(dst[len(src):]).WriteFloat32s(be)
+
+			return le[2] == LE(src[8:]).ReadFloat32() && be[2] == BE(src[8:]).ReadFloat32()
+		}},
+		{8, func(dst, src []byte) bool {
+			le, be := make([]float64, 3), make([]float64, 3)
+			LE(src).ReadFloat64s(le)
+			BE(src).ReadFloat64s(be)
+			LE(dst).WriteFloat64s(le)
+			BE(dst[len(src):]).WriteFloat64s(be)
+
+			return le[2] == LE(src[16:]).ReadFloat64() && be[2] == BE(src[16:]).ReadFloat64()
+		}},
+	} {
+		n := 3 * tt.size
+
+		tmp := make([]byte, 2*n)
+		if !tt.copyValues(tmp, data[:n]) {
+			t.Fatalf("%d: bulk read differs from single read", tt.size)
+		}
+
+		if !bytes.Equal(tmp[:n], data[:n]) || !bytes.Equal(tmp[n:], data[:n]) {
+			t.Fatalf("%d: expected %x twice but got %x", tt.size, data[:n], tmp)
+		}
+
+		// the capacity of the short slices is still large enough, which must be detected as well
+		assertBulkShort(t, func() { tt.copyValues(tmp, data[:n-1]) })
+		assertBulkShort(t, func() { tt.copyValues(tmp[:2*n-1], data[:n]) })
+	}
+}
+
+func assertBulkShort(t *testing.T, f func()) {
+	t.Helper()
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrShortBuffer) {
+			t.Fatalf("expected short buffer panic but got %v", err)
+		}
+	}()
+
+	f()
+}
+
+func TestBulkEmpty(t *testing.T) {
+	Native.ReadUint64s(nil, nil)
+	Native.WriteFloat32s(nil, nil)
+}
+
+func TestBulkOverflow(t *testing.T) {
+	tmp := make([]byte, 16)
+
+	assertOverflow(t, func() { LE(tmp).WriteInt24s([]int32{0, MaxInt24 + 1}) })
+	assertOverflow(t, func() { BE(tmp).WriteInt56s([]int64{MinInt56 - 1}) })
+}
@@ -61,6 +61,52 @@ type ByteOrder interface {
 	// WriteUint64 writes the first 8 bytes. Panics when len(b) < 8.
 	WriteUint64(v uint64)
 
+	// ReadInt16 reads the first 2 bytes as a signed two's complement integer. Panics when len(b) < 2.
+	ReadInt16() int16
+
+	// WriteInt16 writes the first 2 bytes. Panics when len(b) < 2.
+	WriteInt16(v int16)
+
+	// ReadInt24 reads the first 3 bytes as a signed two's complement integer. Panics when len(b) < 3.
+	ReadInt24() int32
+
+	// WriteInt24 writes the first 3 bytes. Panics when len(b) < 3 or if v is not within
+	// [MinInt24, MaxInt24].
+	WriteInt24(v int32)
+
+	// ReadInt32 reads the first 4 bytes as a signed two's complement integer. Panics when len(b) < 4.
+	ReadInt32() int32
+
+	// WriteInt32 writes the first 4 bytes. Panics when len(b) < 4.
+	WriteInt32(v int32)
+
+	// ReadInt40 reads the first 5 bytes as a signed two's complement integer. Panics when len(b) < 5.
+	ReadInt40() int64
+
+	// WriteInt40 writes the first 5 bytes. Panics when len(b) < 5 or if v is not within
+	// [MinInt40, MaxInt40].
+	WriteInt40(v int64)
+
+	// ReadInt48 reads the first 6 bytes as a signed two's complement integer. Panics when len(b) < 6.
+	ReadInt48() int64
+
+	// WriteInt48 writes the first 6 bytes. Panics when len(b) < 6 or if v is not within
+	// [MinInt48, MaxInt48].
+	WriteInt48(v int64)
+
+	// ReadInt56 reads the first 7 bytes as a signed two's complement integer. Panics when len(b) < 7.
+	ReadInt56() int64
+
+	// WriteInt56 writes the first 7 bytes. Panics when len(b) < 7 or if v is not within
+	// [MinInt56, MaxInt56].
+	WriteInt56(v int64)
+
+	// ReadInt64 reads the first 8 bytes as a signed two's complement integer. Panics when len(b) < 8.
+	ReadInt64() int64
+
+	// WriteInt64 writes the first 8 bytes. Panics when len(b) < 8.
+	WriteInt64(v int64)
+
 	// ReadFloat64 reads 8 bytes and interprets them as a float64 IEEE 754 4 byte bit sequence.
 	// Panics when len(b) < 8.
 	ReadFloat64() float64
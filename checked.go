@@ -0,0 +1,376 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// uintOverflow returns an OverflowError, if v does not fit into n bytes. Panics if n is not within [1, 8].
+func uintOverflow(v uint64, n int) error {
+	if v > maxUintOf(n) {
+		return &OverflowError{Value: v, Width: 8 * n} //nolint:gomnd
+	}
+
+	return nil
+}
+
+// checkedUint returns an OverflowError, if v does not fit into n bytes, or a ShortBufferError, if available < n.
+// Panics if n is not within [1, 8].
+func checkedUint(v uint64, n, available int) error {
+	if err := uintOverflow(v, n); err != nil {
+		return err
+	}
+
+	return shortBuffer(n, available)
+}
+
+// checkedInt returns an OverflowError, if v does not fit into n bytes, or a ShortBufferError, if available < n.
+// Panics if n is not within [1, 8].
+func checkedInt(v int64, n, available int) error {
+	if err := intOverflow(v, minIntOf(n), maxIntOf(n), 8*n); err != nil { //nolint:gomnd
+		return err
+	}
+
+	return shortBuffer(n, available)
+}
+
+// CheckedWriteUint is like WriteUint but returns an OverflowError or a ShortBufferError instead of panicking.
+// Panics if n is not within [1, 8].
+func (o Order) CheckedWriteUint(b []byte, n int, v uint64) error {
+	if err := checkedUint(v, n, len(b)); err != nil {
+		return err
+	}
+
+	o.WriteUint(b, n, v)
+
+	return nil
+}
+
+// CheckedWriteInt is like WriteInt but returns an OverflowError or a ShortBufferError instead of panicking.
+// Panics if n is not within [1, 8].
+func (o Order) CheckedWriteInt(b []byte, n int, v int64) error {
+	if err := checkedInt(v, n, len(b)); err != nil {
+		return err
+	}
+
+	o.WriteInt(b, n, v)
+
+	return nil
+}
+
+// CheckedWriteUint is like WriteUint but returns an OverflowError or a ShortBufferError instead of panicking.
+// Panics if n is not within [1, 8].
+func (b LittleEndian) CheckedWriteUint(n int, v uint64) error {
+	return Little.CheckedWriteUint(b, n, v)
+}
+
+// CheckedWriteInt is like WriteInt but returns an OverflowError or a ShortBufferError instead of panicking.
+// Panics if n is not within [1, 8].
+func (b LittleEndian) CheckedWriteInt(n int, v int64) error {
+	return Little.CheckedWriteInt(b, n, v)
+}
+
+// CheckedWriteUint is like WriteUint but returns an OverflowError or a ShortBufferError instead of panicking.
+// Panics if n is not within [1, 8].
+func (b BigEndian) CheckedWriteUint(n int, v uint64) error {
+	return Big.CheckedWriteUint(b, n, v)
+}
+
+// CheckedWriteInt is like WriteInt but returns an OverflowError or a ShortBufferError instead of panicking.
+// Panics if n is not within [1, 8].
+func (b BigEndian) CheckedWriteInt(n int, v int64) error {
+	return Big.CheckedWriteInt(b, n, v)
+}
+
+// CheckedWriteUint8 writes the first byte. Returns an OverflowError if v > MaxUint8 or a ShortBufferError
+// if len(b) < 1.
+func (o Order) CheckedWriteUint8(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 1, v)
+}
+
+// CheckedWriteUint16 writes the first 2 bytes. Returns an OverflowError if v > MaxUint16 or a ShortBufferError
+// if len(b) < 2.
+func (o Order) CheckedWriteUint16(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 2, v) //nolint:gomnd
+}
+
+// CheckedWriteUint24 writes the first 3 bytes. Returns an OverflowError if v > MaxUint24 or a ShortBufferError
+// if len(b) < 3.
+func (o Order) CheckedWriteUint24(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 3, v) //nolint:gomnd
+}
+
+// CheckedWriteUint32 writes the first 4 bytes. Returns an OverflowError if v > MaxUint32 or a ShortBufferError
+// if len(b) < 4.
+func (o Order) CheckedWriteUint32(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 4, v) //nolint:gomnd
+}
+
+// CheckedWriteUint40 writes the first 5 bytes. Returns an OverflowError if v > MaxUint40 or a ShortBufferError
+// if len(b) < 5.
+func (o Order) CheckedWriteUint40(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 5, v) //nolint:gomnd
+}
+
+// CheckedWriteUint48 writes the first 6 bytes. Returns an OverflowError if v > MaxUint48 or a ShortBufferError
+// if len(b) < 6.
+func (o Order) CheckedWriteUint48(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 6, v) //nolint:gomnd
+}
+
+// CheckedWriteUint56 writes the first 7 bytes. Returns an OverflowError if v > MaxUint56 or a ShortBufferError
+// if len(b) < 7.
+func (o Order) CheckedWriteUint56(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 7, v) //nolint:gomnd
+}
+
+// CheckedWriteUint64 writes the first 8 bytes. Returns a ShortBufferError if len(b) < 8.
+func (o Order) CheckedWriteUint64(b []byte, v uint64) error {
+	return o.CheckedWriteUint(b, 8, v) //nolint:gomnd
+}
+
+// CheckedWriteInt8 writes the first byte. Returns an OverflowError if v is not within [MinInt8, MaxInt8]
+// or a ShortBufferError if len(b) < 1.
+func (o Order) CheckedWriteInt8(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 1, v)
+}
+
+// CheckedWriteInt16 writes the first 2 bytes. Returns an OverflowError if v is not within [MinInt16, MaxInt16]
+// or a ShortBufferError if len(b) < 2.
+func (o Order) CheckedWriteInt16(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 2, v) //nolint:gomnd
+}
+
+// CheckedWriteInt24 writes the first 3 bytes. Returns an OverflowError if v is not within [MinInt24, MaxInt24]
+// or a ShortBufferError if len(b) < 3.
+func (o Order) CheckedWriteInt24(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 3, v) //nolint:gomnd
+}
+
+// CheckedWriteInt32 writes the first 4 bytes. Returns an OverflowError if v is not within [MinInt32, MaxInt32]
+// or a ShortBufferError if len(b) < 4.
+func (o Order) CheckedWriteInt32(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 4, v) //nolint:gomnd
+}
+
+// CheckedWriteInt40 writes the first 5 bytes. Returns an OverflowError if v is not within [MinInt40, MaxInt40]
+// or a ShortBufferError if len(b) < 5.
+func (o Order) CheckedWriteInt40(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 5, v) //nolint:gomnd
+}
+
+// CheckedWriteInt48 writes the first 6 bytes. Returns an OverflowError if v is not within [MinInt48, MaxInt48]
+// or a ShortBufferError if len(b) < 6.
+func (o Order) CheckedWriteInt48(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 6, v) //nolint:gomnd
+}
+
+// CheckedWriteInt56 writes the first 7 bytes. Returns an OverflowError if v is not within [MinInt56, MaxInt56]
+// or a ShortBufferError if len(b) < 7.
+func (o Order) CheckedWriteInt56(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 7, v) //nolint:gomnd
+}
+
+// CheckedWriteInt64 writes the first 8 bytes. Returns a ShortBufferError if len(b) < 8.
+func (o Order) CheckedWriteInt64(b []byte, v int64) error {
+	return o.CheckedWriteInt(b, 8, v) //nolint:gomnd
+}
+
+// CheckedWriteUint8 writes the first byte. Returns an OverflowError if v > MaxUint8 or a ShortBufferError
+// if len(b) < 1.
+func (b LittleEndian) CheckedWriteUint8(v uint64) error {
+	return Little.CheckedWriteUint(b, 1, v)
+}
+
+// CheckedWriteUint16 writes the first 2 bytes. Returns an OverflowError if v > MaxUint16 or a ShortBufferError
+// if len(b) < 2.
+func (b LittleEndian) CheckedWriteUint16(v uint64) error {
+	return Little.CheckedWriteUint(b, 2, v) //nolint:gomnd
+}
+
+// CheckedWriteUint24 writes the first 3 bytes. Returns an OverflowError if v > MaxUint24 or a ShortBufferError
+// if len(b) < 3.
+func (b LittleEndian) CheckedWriteUint24(v uint64) error {
+	return Little.CheckedWriteUint(b, 3, v) //nolint:gomnd
+}
+
+// CheckedWriteUint32 writes the first 4 bytes. Returns an OverflowError if v > MaxUint32 or a ShortBufferError
+// if len(b) < 4.
+func (b LittleEndian) CheckedWriteUint32(v uint64) error {
+	return Little.CheckedWriteUint(b, 4, v) //nolint:gomnd
+}
+
+// CheckedWriteUint40 writes the first 5 bytes. Returns an OverflowError if v > MaxUint40 or a ShortBufferError
+// if len(b) < 5.
+func (b LittleEndian) CheckedWriteUint40(v uint64) error {
+	return Little.CheckedWriteUint(b, 5, v) //nolint:gomnd
+}
+
+// CheckedWriteUint48 writes the first 6 bytes. Returns an OverflowError if v > MaxUint48 or a ShortBufferError
+// if len(b) < 6.
+func (b LittleEndian) CheckedWriteUint48(v uint64) error {
+	return Little.CheckedWriteUint(b, 6, v) //nolint:gomnd
+}
+
+// CheckedWriteUint56 writes the first 7 bytes. Returns an OverflowError if v > MaxUint56 or a ShortBufferError
+// if len(b) < 7.
+func (b LittleEndian) CheckedWriteUint56(v uint64) error {
+	return Little.CheckedWriteUint(b, 7, v) //nolint:gomnd
+}
+
+// CheckedWriteUint64 writes the first 8 bytes. Returns a ShortBufferError if len(b) < 8.
+func (b LittleEndian) CheckedWriteUint64(v uint64) error {
+	return Little.CheckedWriteUint(b, 8, v) //nolint:gomnd
+}
+
+// CheckedWriteInt8 writes the first byte. Returns an OverflowError if v is not within [MinInt8, MaxInt8]
+// or a ShortBufferError if len(b) < 1.
+func (b LittleEndian) CheckedWriteInt8(v int64) error {
+	return Little.CheckedWriteInt(b, 1, v)
+}
+
+// CheckedWriteInt16 writes the first 2 bytes. Returns an OverflowError if v is not within [MinInt16, MaxInt16]
+// or a ShortBufferError if len(b) < 2.
+func (b LittleEndian) CheckedWriteInt16(v int64) error {
+	return Little.CheckedWriteInt(b, 2, v) //nolint:gomnd
+}
+
+// CheckedWriteInt24 writes the first 3 bytes. Returns an OverflowError if v is not within [MinInt24, MaxInt24]
+// or a ShortBufferError if len(b) < 3.
+func (b LittleEndian) CheckedWriteInt24(v int64) error {
+	return Little.CheckedWriteInt(b, 3, v) //nolint:gomnd
+}
+
+// CheckedWriteInt32 writes the first 4 bytes. Returns an OverflowError if v is not within [MinInt32, MaxInt32]
+// or a ShortBufferError if len(b) < 4.
+func (b LittleEndian) CheckedWriteInt32(v int64) error {
+	return Little.CheckedWriteInt(b, 4, v) //nolint:gomnd
+}
+
+// CheckedWriteInt40 writes the first 5 bytes. Returns an OverflowError if v is not within [MinInt40, MaxInt40]
+// or a ShortBufferError if len(b) < 5.
+func (b LittleEndian) CheckedWriteInt40(v int64) error {
+	return Little.CheckedWriteInt(b, 5, v) //nolint:gomnd
+}
+
+// CheckedWriteInt48 writes the first 6 bytes. Returns an OverflowError if v is not within [MinInt48, MaxInt48]
+// or a ShortBufferError if len(b) < 6.
+func (b LittleEndian) CheckedWriteInt48(v int64) error {
+	return Little.CheckedWriteInt(b, 6, v) //nolint:gomnd
+}
+
+// CheckedWriteInt56 writes the first 7 bytes. Returns an OverflowError if v is not within [MinInt56, MaxInt56]
+// or a ShortBufferError if len(b) < 7.
+func (b LittleEndian) CheckedWriteInt56(v int64) error {
+	return Little.CheckedWriteInt(b, 7, v) //nolint:gomnd
+}
+
+// CheckedWriteInt64 writes the first 8 bytes. Returns a ShortBufferError if len(b) < 8.
+func (b LittleEndian) CheckedWriteInt64(v int64) error {
+	return Little.CheckedWriteInt(b, 8, v) //nolint:gomnd
+}
+
+// CheckedWriteUint8 writes the first byte. Returns an OverflowError if v > MaxUint8 or a ShortBufferError
+// if len(b) < 1.
+func (b BigEndian) CheckedWriteUint8(v uint64) error {
+	return Big.CheckedWriteUint(b, 1, v)
+}
+
+// CheckedWriteUint16 writes the first 2 bytes. Returns an OverflowError if v > MaxUint16 or a ShortBufferError
+// if len(b) < 2.
+func (b BigEndian) CheckedWriteUint16(v uint64) error {
+	return Big.CheckedWriteUint(b, 2, v) //nolint:gomnd
+}
+
+// CheckedWriteUint24 writes the first 3 bytes. Returns an OverflowError if v > MaxUint24 or a ShortBufferError
+// if len(b) < 3.
+func (b BigEndian) CheckedWriteUint24(v uint64) error {
+	return Big.CheckedWriteUint(b, 3, v) //nolint:gomnd
+}
+
+// CheckedWriteUint32 writes the first 4 bytes. Returns an OverflowError if v > MaxUint32 or a ShortBufferError
+// if len(b) < 4.
+func (b BigEndian) CheckedWriteUint32(v uint64) error {
+	return Big.CheckedWriteUint(b, 4, v) //nolint:gomnd
+}
+
+// CheckedWriteUint40 writes the first 5 bytes. Returns an OverflowError if v > MaxUint40 or a ShortBufferError
+// if len(b) < 5.
+func (b BigEndian) CheckedWriteUint40(v uint64) error {
+	return Big.CheckedWriteUint(b, 5, v) //nolint:gomnd
+}
+
+// CheckedWriteUint48 writes the first 6 bytes. Returns an OverflowError if v > MaxUint48 or a ShortBufferError
+// if len(b) < 6.
+func (b BigEndian) CheckedWriteUint48(v uint64) error {
+	return Big.CheckedWriteUint(b, 6, v) //nolint:gomnd
+}
+
+// CheckedWriteUint56 writes the first 7 bytes. Returns an OverflowError if v > MaxUint56 or a ShortBufferError
+// if len(b) < 7.
+func (b BigEndian) CheckedWriteUint56(v uint64) error {
+	return Big.CheckedWriteUint(b, 7, v) //nolint:gomnd
+}
+
+// CheckedWriteUint64 writes the first 8 bytes. Returns a ShortBufferError if len(b) < 8.
+func (b BigEndian) CheckedWriteUint64(v uint64) error {
+	return Big.CheckedWriteUint(b, 8, v) //nolint:gomnd
+}
+
+// CheckedWriteInt8 writes the first byte. Returns an OverflowError if v is not within [MinInt8, MaxInt8]
+// or a ShortBufferError if len(b) < 1.
+func (b BigEndian) CheckedWriteInt8(v int64) error {
+	return Big.CheckedWriteInt(b, 1, v)
+}
+
+// CheckedWriteInt16 writes the first 2 bytes. Returns an OverflowError if v is not within [MinInt16, MaxInt16]
+// or a ShortBufferError if len(b) < 2.
+func (b BigEndian) CheckedWriteInt16(v int64) error {
+	return Big.CheckedWriteInt(b, 2, v) //nolint:gomnd
+}
+
+// CheckedWriteInt24 writes the first 3 bytes. Returns an OverflowError if v is not within [MinInt24, MaxInt24]
+// or a ShortBufferError if len(b) < 3.
+func (b BigEndian) CheckedWriteInt24(v int64) error {
+	return Big.CheckedWriteInt(b, 3, v) //nolint:gomnd
+}
+
+// CheckedWriteInt32 writes the first 4 bytes. Returns an OverflowError if v is not within [MinInt32, MaxInt32]
+// or a ShortBufferError if len(b) < 4.
+func (b BigEndian) CheckedWriteInt32(v int64) error {
+	return Big.CheckedWriteInt(b, 4, v) //nolint:gomnd
+}
+
+// CheckedWriteInt40 writes the first 5 bytes. Returns an OverflowError if v is not within [MinInt40, MaxInt40]
+// or a ShortBufferError if len(b) < 5.
+func (b BigEndian) CheckedWriteInt40(v int64) error {
+	return Big.CheckedWriteInt(b, 5, v) //nolint:gomnd
+}
+
+// CheckedWriteInt48 writes the first 6 bytes. Returns an OverflowError if v is not within [MinInt48, MaxInt48]
+// or a ShortBufferError if len(b) < 6.
+func (b BigEndian) CheckedWriteInt48(v int64) error {
+	return Big.CheckedWriteInt(b, 6, v) //nolint:gomnd
+}
+
+// CheckedWriteInt56 writes the first 7 bytes. Returns an OverflowError if v is not within [MinInt56, MaxInt56]
+// or a ShortBufferError if len(b) < 7.
+func (b BigEndian) CheckedWriteInt56(v int64) error {
+	return Big.CheckedWriteInt(b, 7, v) //nolint:gomnd
+}
+
+// CheckedWriteInt64 writes the first 8 bytes. Returns a ShortBufferError if len(b) < 8.
+func (b BigEndian) CheckedWriteInt64(v int64) error {
+	return Big.CheckedWriteInt(b, 8, v) //nolint:gomnd
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestCheckedWriteUint(t *testing.T) {
+	for _, tt := range []struct {
+		n     int
+		order func(Order, []byte, uint64) error
+		le    func(LE, uint64) error
+		be    func(BE, uint64) error
+	}{
+		{1, Order.CheckedWriteUint8, LE.CheckedWriteUint8, BE.CheckedWriteUint8},
+		{2, Order.CheckedWriteUint16, LE.CheckedWriteUint16, BE.CheckedWriteUint16},
+		{3, Order.CheckedWriteUint24, LE.CheckedWriteUint24, BE.CheckedWriteUint24},
+		{4, Order.CheckedWriteUint32, LE.CheckedWriteUint32, BE.CheckedWriteUint32},
+		{5, Order.CheckedWriteUint40, LE.CheckedWriteUint40, BE.CheckedWriteUint40},
+		{6, Order.CheckedWriteUint48, LE.CheckedWriteUint48, BE.CheckedWriteUint48},
+		{7, Order.CheckedWriteUint56, LE.CheckedWriteUint56, BE.CheckedWriteUint56},
+		{8, Order.CheckedWriteUint64, LE.CheckedWriteUint64, BE.CheckedWriteUint64},
+	} {
+		n := tt.n
+		max := MaxUint64 >> (64 - 8*n)
+
+		for _, order := range []Order{Little, Big} {
+			view := func(b []byte, v uint64) error { return tt.be(BE(b), v) }
+			generic := func(b []byte, v uint64) error { return BE(b).CheckedWriteUint(n, v) }
+
+			if order == Little {
+				view = func(b []byte, v uint64) error { return tt.le(LE(b), v) }
+				generic = func(b []byte, v uint64) error { return LE(b).CheckedWriteUint(n, v) }
+			}
+
+			expected := make([]byte, n)
+			order.WriteUint(expected, n, max)
+
+			for _, write := range []func([]byte, uint64) error{
+				func(b []byte, v uint64) error { return tt.order(order, b, v) },
+				func(b []byte, v uint64) error { return order.CheckedWriteUint(b, n, v) },
+				view,
+				generic,
+			} {
+				tmp := make([]byte, n)
+				if err := write(tmp, max); err != nil || !bytes.Equal(tmp, expected) {
+					t.Fatalf("%v %d: expected %X but got %X %v", order, n, expected, tmp, err)
+				}
+
+				if err := write(tmp[1:], max); !errors.Is(err, ErrShortBuffer) {
+					t.Fatalf("%v %d: expected short buffer but got %v", order, n, err)
+				}
+
+				if n < 8 {
+					tmp = make([]byte, n)
+					assertCheckedOverflow(t, write(tmp, max+1), max+1, n)
+					assertUnchanged(t, tmp)
+				}
+			}
+		}
+	}
+
+	assertWidthPanics(t, 9, func() { _ = Little.CheckedWriteUint(nil, 9, 0) })
+}
+
+func TestCheckedWriteInt(t *testing.T) {
+	for _, tt := range []struct {
+		n     int
+		order func(Order, []byte, int64) error
+		le    func(LE, int64) error
+		be    func(BE, int64) error
+	}{
+		{1, Order.CheckedWriteInt8, LE.CheckedWriteInt8, BE.CheckedWriteInt8},
+		{2, Order.CheckedWriteInt16, LE.CheckedWriteInt16, BE.CheckedWriteInt16},
+		{3, Order.CheckedWriteInt24, LE.CheckedWriteInt24, BE.CheckedWriteInt24},
+		{4, Order.CheckedWriteInt32, LE.CheckedWriteInt32, BE.CheckedWriteInt32},
+		{5, Order.CheckedWriteInt40, LE.CheckedWriteInt40, BE.CheckedWriteInt40},
+		{6, Order.CheckedWriteInt48, LE.CheckedWriteInt48, BE.CheckedWriteInt48},
+		{7, Order.CheckedWriteInt56, LE.CheckedWriteInt56, BE.CheckedWriteInt56},
+		{8, Order.CheckedWriteInt64, LE.CheckedWriteInt64, BE.CheckedWriteInt64},
+	} {
+		n := tt.n
+		min := int64(-1) << (8*n - 1)
+		max := ^min
+
+		for _, order := range []Order{Little, Big} {
+			view := func(b []byte, v int64) error { return tt.be(BE(b), v) }
+			generic := func(b []byte, v int64) error { return BE(b).CheckedWriteInt(n, v) }
+
+			if order == Little {
+				view = func(b []byte, v int64) error { return tt.le(LE(b), v) }
+				generic = func(b []byte, v int64) error { return LE(b).CheckedWriteInt(n, v) }
+			}
+
+			expected := make([]byte, n)
+			order.WriteInt(expected, n, min)
+
+			for _, write := range []func([]byte, int64) error{
+				func(b []byte, v int64) error { return tt.order(order, b, v) },
+				func(b []byte, v int64) error { return order.CheckedWriteInt(b, n, v) },
+				view,
+				generic,
+			} {
+				tmp := make([]byte, n)
+				if err := write(tmp, min); err != nil || !bytes.Equal(tmp, expected) {
+					t.Fatalf("%v %d: expected %X but got %X %v", order, n, expected, tmp, err)
+				}
+
+				if err := write(tmp[1:], min); !errors.Is(err, ErrShortBuffer) {
+					t.Fatalf("%v %d: expected short buffer but got %v", order, n, err)
+				}
+
+				if n < 8 {
+					tmp = make([]byte, n)
+					assertCheckedOverflow(t, write(tmp, min-1), min-1, n)
+					assertCheckedOverflow(t, write(tmp, max+1), max+1, n)
+					assertUnchanged(t, tmp)
+				}
+			}
+		}
+	}
+
+	assertWidthPanics(t, 0, func() { _ = Big.CheckedWriteInt(nil, 0, 0) })
+}
+
+func assertCheckedOverflow(t *testing.T, err error, value interface{}, n int) {
+	t.Helper()
+
+	var overflow *OverflowError
+	if !errors.As(err, &overflow) || !errors.Is(err, ErrOverflow) || overflow.Value != value || overflow.Width != 8*n {
+		t.Fatalf("%d: expected overflow of %v but got %v", n, value, err)
+	}
+}
+
+func assertUnchanged(t *testing.T, b []byte) {
+	t.Helper()
+
+	if !bytes.Equal(b, make([]byte, len(b))) {
+		t.Fatalf("expected that nothing has been written but got %X", b)
+	}
+}
@@ -0,0 +1,589 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const importPath = "github.com/worldiety/byteorder"
+
+// A basic describes a predeclared Go type.
+type basic struct {
+	kind     reflect.Kind
+	name     string // canonical name, e.g. uint8 for byte
+	bits     int    // size in bits, limited to a portable 32 bit for platform dependent types like byteorder.Marshal
+	platform bool   // true if the size depends on the platform
+}
+
+//nolint:gochecknoglobals
+var basics = map[string]basic{
+	"bool":    {reflect.Bool, "bool", 8, false},
+	"byte":    {reflect.Uint8, "uint8", 8, false},
+	"uint8":   {reflect.Uint8, "uint8", 8, false},
+	"uint16":  {reflect.Uint16, "uint16", 16, false},
+	"uint32":  {reflect.Uint32, "uint32", 32, false},
+	"uint64":  {reflect.Uint64, "uint64", 64, false},
+	"uint":    {reflect.Uint, "uint", 32, true},
+	"uintptr": {reflect.Uintptr, "uintptr", 32, true},
+	"int8":    {reflect.Int8, "int8", 8, false},
+	"int16":   {reflect.Int16, "int16", 16, false},
+	"int32":   {reflect.Int32, "int32", 32, false},
+	"rune":    {reflect.Int32, "int32", 32, false},
+	"int64":   {reflect.Int64, "int64", 64, false},
+	"int":     {reflect.Int, "int", 32, true},
+	"float32": {reflect.Float32, "float32", 32, false},
+	"float64": {reflect.Float64, "float64", 64, false},
+}
+
+// A typeSpec is a named type declared in the parsed package.
+type typeSpec struct {
+	expr ast.Expr
+	imp  string // local name of the byteorder import in the declaring file or empty
+}
+
+// A tag is the parsed representation of a byteorder struct tag like `byteorder:"i40,be,skip=3"`, using the same
+// syntax as byteorder.Marshal.
+type tag struct {
+	typ   string // wire type like u24, i40, f16 or empty for the default of the Go type
+	order string // LE, BE or empty to inherit the order
+	skip  int
+}
+
+// parseTag parses the comma separated options of a byteorder struct tag.
+func parseTag(s string) (tag, error) {
+	var tg tag
+
+	for _, opt := range strings.Split(s, ",") {
+		switch {
+		case opt == "":
+		case opt == "le":
+			tg.order = "LE"
+		case opt == "be":
+			tg.order = "BE"
+		case strings.HasPrefix(opt, "skip="):
+			n, err := strconv.Atoi(opt[len("skip="):])
+			if err != nil || n < 0 {
+				return tg, fmt.Errorf("invalid option %q", opt)
+			}
+
+			tg.skip = n
+		case tg.typ != "":
+			return tg, fmt.Errorf("duplicate type %q", opt)
+		default:
+			tg.typ = opt
+		}
+	}
+
+	return tg, nil
+}
+
+// A body collects the statements of the generated AppendBinary and UnmarshalBinary methods.
+type body struct {
+	enc bytes.Buffer
+	dec bytes.Buffer
+}
+
+// A generator emits the Size, AppendBinary, MarshalBinary and UnmarshalBinary methods of struct types declared in
+// a single package.
+type generator struct {
+	pkg   string
+	types map[string]typeSpec
+}
+
+// parseDir parses all non-test Go files of the package in dir.
+func parseDir(dir string) (*generator, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %s but found %d", dir, len(pkgs))
+	}
+
+	g := &generator{types: map[string]typeSpec{}}
+
+	for name, pkg := range pkgs {
+		g.pkg = name
+
+		for _, file := range pkg.Files {
+			imp := importName(file)
+
+			for _, decl := range file.Decls {
+				if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+					for _, spec := range gen.Specs {
+						ts := spec.(*ast.TypeSpec)
+						g.types[ts.Name.Name] = typeSpec{expr: ts.Type, imp: imp}
+					}
+				}
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// importName returns the local name of the byteorder import of file or the empty string.
+func importName(file *ast.File) string {
+	for _, spec := range file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == importPath {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+
+			return "byteorder"
+		}
+	}
+
+	return ""
+}
+
+// generate returns the formatted source of the methods of the named struct types, using order (le or be) for all
+// fields without an explicit order.
+func (g *generator) generate(names []string, order string) ([]byte, error) {
+	switch order {
+	case "le", "be":
+		order = strings.ToUpper(order)
+	default:
+		return nil, fmt.Errorf("invalid order %q", order)
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by byteorder-gen; DO NOT EDIT.\n\npackage %s\n\nimport %q\n", g.pkg, importPath)
+
+	for _, name := range names {
+		spec, ok := g.types[name]
+		if !ok {
+			return nil, fmt.Errorf("type %s not found", name)
+		}
+
+		st, ok := spec.expr.(*ast.StructType)
+		if !ok {
+			return nil, fmt.Errorf("type %s is not a struct", name)
+		}
+
+		var b body
+
+		size, err := g.emitStruct(&b, st, "v", "0", 0, spec.imp, order)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", name, err)
+		}
+
+		writeMethods(&buf, name, order, size, &b)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// writeMethods writes the methods of the type name with the given encoded size and statements.
+func writeMethods(buf *bytes.Buffer, name, order string, size int, b *body) {
+	orderName := map[string]string{"LE": "little-endian", "BE": "big-endian"}[order]
+
+	fmt.Fprintf(buf, `
+// Size returns the encoded size of %[1]s in bytes.
+func (v *%[1]s) Size() int {
+	return %[2]d
+}
+
+// AppendBinary appends the %[3]s encoding of v to dst. Returns a byteorder.OverflowError if a value does not
+// fit into its tagged width.
+func (v *%[1]s) AppendBinary(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = append(dst, make([]byte, %[2]d)...)
+`, name, size, orderName)
+
+	if b.enc.Len() > 0 {
+		fmt.Fprintf(buf, "b := dst[n:]\n%s", b.enc.Bytes())
+	}
+
+	fmt.Fprintf(buf, `
+	return dst, nil
+}
+
+// MarshalBinary returns the %[3]s encoding of v.
+func (v *%[1]s) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, %[2]d))
+}
+
+// UnmarshalBinary decodes the first %[2]d bytes of b in %[3]s order into v. Returns a byteorder.ShortBufferError
+// if b is too small.
+func (v *%[1]s) UnmarshalBinary(b []byte) error {
+	if len(b) < %[2]d {
+		return &byteorder.ShortBufferError{Needed: %[2]d, Available: len(b)}
+	}
+
+%[4]s
+	return nil
+}
+`, name, size, orderName, b.dec.Bytes())
+}
+
+// emitStruct emits the statements for the fields of st, where x is the expression denoting the struct value and
+// off the expression of its offset. Returns the encoded size.
+func (g *generator) emitStruct(b *body, st *ast.StructType, x, off string, depth int, imp, order string) (int, error) {
+	size := 0
+
+	for _, f := range st.Fields.List {
+		s := ""
+		if f.Tag != nil {
+			lit, _ := strconv.Unquote(f.Tag.Value)
+			s = reflect.StructTag(lit).Get("byteorder")
+		}
+
+		if s == "-" {
+			continue
+		}
+
+		tg, err := parseTag(s)
+		if err != nil {
+			return 0, err
+		}
+
+		fieldOrder := order
+		if tg.order != "" {
+			fieldOrder = tg.order
+		}
+
+		for _, name := range fieldNames(f) {
+			if !ast.IsExported(name) && name != "_" {
+				continue
+			}
+
+			size += tg.skip
+			dst := b
+
+			if name == "_" {
+				dst = &body{}
+			}
+
+			n, err := g.emit(dst, f.Type, tg, x+"."+name, offset(off, size), depth, imp, fieldOrder, "")
+			if err != nil {
+				return 0, err
+			}
+
+			size += n
+		}
+	}
+
+	return size, nil
+}
+
+// fieldNames returns the names of f, which is the type name for an embedded field.
+func fieldNames(f *ast.Field) []string {
+	if len(f.Names) == 0 {
+		t := f.Type
+		if star, ok := t.(*ast.StarExpr); ok {
+			t = star.X
+		}
+
+		if sel, ok := t.(*ast.SelectorExpr); ok {
+			t = sel.Sel
+		}
+
+		return []string{t.(*ast.Ident).Name}
+	}
+
+	names := make([]string, 0, len(f.Names))
+	for _, n := range f.Names {
+		names = append(names, n.Name)
+	}
+
+	return names
+}
+
+// offset returns the expression of base+add.
+func offset(base string, add int) string {
+	if n, err := strconv.Atoi(base); err == nil {
+		return strconv.Itoa(n + add)
+	}
+
+	if add == 0 {
+		return base
+	}
+
+	return base + "+" + strconv.Itoa(add)
+}
+
+// emit emits the statements for the value x of type t at offset off and returns its encoded size. The conv type is
+// the named type of x, if any.
+func (g *generator) emit(b *body, t ast.Expr, tg tag, x, off string, depth int, imp, order, conv string) (int, error) {
+	switch e := t.(type) {
+	case *ast.Ident:
+		if bt, ok := basics[e.Name]; ok {
+			if conv == "" {
+				conv = bt.name
+			}
+
+			return emitScalar(b, bt, tg, x, off, order, conv)
+		}
+
+		spec, ok := g.types[e.Name]
+		if !ok {
+			return 0, fmt.Errorf("field %s: unsupported type %s", x, e.Name)
+		}
+
+		if conv == "" {
+			conv = e.Name
+		}
+
+		return g.emit(b, spec.expr, tg, x, off, depth, spec.imp, order, conv)
+	case *ast.SelectorExpr:
+		if id, ok := e.X.(*ast.Ident); ok && imp != "" && id.Name == imp {
+			switch e.Sel.Name {
+			case "Uint128", "Int128":
+				if conv == "" {
+					conv = "byteorder." + e.Sel.Name
+				}
+
+				return emit128(b, e.Sel.Name, tg, x, off, order, conv)
+			}
+		}
+
+		return 0, fmt.Errorf("field %s: unsupported type", x)
+	case *ast.ArrayType:
+		return g.emitArray(b, e, tg, x, off, depth, imp, order)
+	case *ast.StructType:
+		if tg.typ != "" {
+			return 0, fmt.Errorf("field %s: unsupported type %q for a struct", x, tg.typ)
+		}
+
+		return g.emitStruct(b, e, x, off, depth, imp, order)
+	default:
+		return 0, fmt.Errorf("field %s: unsupported type", x)
+	}
+}
+
+// emitArray emits a loop over the elements of the array x.
+func (g *generator) emitArray(
+	b *body, t *ast.ArrayType, tg tag, x, off string, depth int, imp, order string,
+) (int, error) {
+	lit, ok := t.Len.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, fmt.Errorf("field %s: unsupported slice or array length", x)
+	}
+
+	n, err := strconv.ParseInt(lit.Value, 0, strconv.IntSize)
+	if err != nil {
+		return 0, fmt.Errorf("field %s: unsupported array length %s", x, lit.Value)
+	}
+
+	i := "i" + strconv.Itoa(depth)
+	tg.skip = 0
+
+	size, err := g.emit(&body{}, t.Elt, tg, x, off, depth+1, imp, order, "")
+	if err != nil {
+		return 0, err
+	}
+
+	elemOff := i
+	if size != 1 {
+		elemOff += "*" + strconv.Itoa(size)
+	}
+
+	if off != "0" {
+		elemOff = off + "+" + elemOff
+	}
+
+	var elem body
+	if _, err := g.emit(&elem, t.Elt, tg, x+"["+i+"]", elemOff, depth+1, imp, order, ""); err != nil {
+		return 0, err
+	}
+
+	loop := fmt.Sprintf("for %[1]s := 0; %[1]s < %[2]d; %[1]s++ {\n", i, n)
+	fmt.Fprintf(&b.enc, "%s%s}\n", loop, elem.enc.Bytes())
+	fmt.Fprintf(&b.dec, "%s%s}\n", loop, elem.dec.Bytes())
+
+	return int(n) * size, nil
+}
+
+// convert returns the expression converting x from type from to type to, if required.
+func convert(to, from, x string) string {
+	if to == from {
+		return x
+	}
+
+	return to + "(" + x + ")"
+}
+
+// wireBits returns the width in bits of an integer wire type like u24, where prefix is either u or i.
+func wireBits(bt basic, typ, prefix, x string) (int, error) {
+	if typ == "" {
+		if bt.platform {
+			return 0, fmt.Errorf("field %s: platform dependent type %s requires a width tag", x, bt.name)
+		}
+
+		return bt.bits, nil
+	}
+
+	bits, err := strconv.Atoi(strings.TrimPrefix(typ, prefix))
+	if err != nil || !strings.HasPrefix(typ, prefix) || bits < 8 || bits > 64 || bits%8 != 0 {
+		return 0, fmt.Errorf("field %s: unsupported type %q", x, typ)
+	}
+
+	if bits > bt.bits && bt.platform {
+		return 0, fmt.Errorf("field %s: type %q is wider than the portable 32 bit of %s", x, typ, bt.name)
+	}
+
+	if bits > bt.bits {
+		return 0, fmt.Errorf("field %s: type %q is wider than %s", x, typ, bt.name)
+	}
+
+	return bits, nil
+}
+
+// viewOf returns the expression of the LE or BE view of b at offset off.
+func viewOf(order, off string) string {
+	if off == "0" {
+		return "byteorder." + order + "(b)"
+	}
+
+	return "byteorder." + order + "(b[" + off + ":])"
+}
+
+// emitScalar emits the statements of a boolean, integer or float value.
+func emitScalar(b *body, bt basic, tg tag, x, off, order, conv string) (int, error) {
+	view := viewOf(order, off)
+
+	switch bt.kind {
+	case reflect.Bool:
+		if tg.typ != "" && tg.typ != "u8" {
+			return 0, fmt.Errorf("field %s: unsupported type %q", x, tg.typ)
+		}
+
+		fmt.Fprintf(&b.enc, "if %s {\nb[%s] = 1\n}\n", x, off)
+		fmt.Fprintf(&b.dec, "%s = %s\n", x, convert(conv, "bool", "b["+off+"] != 0"))
+
+		return 1, nil
+	case reflect.Float32, reflect.Float64:
+		return emitFloat(b, bt, tg, x, view, conv)
+	}
+
+	signed := bt.kind >= reflect.Int && bt.kind <= reflect.Int64
+	prefix, name, param := "u", "Uint", "uint"
+
+	if signed {
+		prefix, name, param = "i", "Int", "int"
+	}
+
+	bits, err := wireBits(bt, tg.typ, prefix, x)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case bits == 16: //nolint:gomnd
+		param += "16"
+	case bits <= 32: //nolint:gomnd
+		param += "32"
+	default:
+		param += "64"
+	}
+
+	if bits < bt.bits || bt.platform {
+		if signed {
+			v := convert("int64", conv, x)
+			fmt.Fprintf(&b.enc, "if %[1]s < int64(byteorder.MinInt%[2]d) || %[1]s > int64(byteorder.MaxInt%[2]d) {\n", v, bits)
+		} else {
+			fmt.Fprintf(&b.enc, "if %s > uint64(byteorder.MaxUint%d) {\n", convert("uint64", conv, x), bits)
+		}
+
+		fmt.Fprintf(&b.enc, "return dst[:n], &byteorder.OverflowError{Value: %s, Width: %d}\n}\n", x, bits)
+	}
+
+	if bits == 8 { //nolint:gomnd
+		fmt.Fprintf(&b.enc, "b[%s] = %s\n", off, convert("uint8", conv, x))
+
+		if signed {
+			fmt.Fprintf(&b.dec, "%s = %s\n", x, convert(conv, "int8", "int8(b["+off+"])"))
+		} else {
+			fmt.Fprintf(&b.dec, "%s = %s\n", x, convert(conv, "uint8", "b["+off+"]"))
+		}
+
+		return 1, nil
+	}
+
+	fmt.Fprintf(&b.enc, "%s.Write%s%d(%s)\n", view, name, bits, convert(param, conv, x))
+	fmt.Fprintf(&b.dec, "%s = %s\n", x, convert(conv, param, fmt.Sprintf("%s.Read%s%d()", view, name, bits)))
+
+	return bits / 8, nil //nolint:gomnd
+}
+
+// emitFloat emits the statements of a float value with an optional wire type f16, bf16, f32 or f64.
+func emitFloat(b *body, bt basic, tg tag, x, view, conv string) (int, error) {
+	typ := tg.typ
+	if typ == "" {
+		typ = "f" + strconv.Itoa(bt.bits)
+	}
+
+	var method, param string
+
+	switch typ {
+	case "f16":
+		method, param = "Float16", "float32"
+	case "bf16":
+		method, param = "BFloat16", "float32"
+	case "f32":
+		method, param = "Float32", "float32"
+	case "f64":
+		method, param = "Float64", "float64"
+	default:
+		return 0, fmt.Errorf("field %s: unsupported type %q", x, typ)
+	}
+
+	fmt.Fprintf(&b.enc, "%s.Write%s(%s)\n", view, method, convert(param, conv, x))
+	fmt.Fprintf(&b.dec, "%s = %s\n", x, convert(conv, param, view+".Read"+method+"()"))
+
+	if typ == "f64" {
+		return 8, nil //nolint:gomnd
+	}
+
+	if typ == "f32" {
+		return 4, nil //nolint:gomnd
+	}
+
+	return 2, nil //nolint:gomnd
+}
+
+// emit128 emits the statements of a byteorder.Uint128 or byteorder.Int128 value.
+func emit128(b *body, name string, tg tag, x, off, order, conv string) (int, error) {
+	if def := map[string]string{"Uint128": "u128", "Int128": "i128"}[name]; tg.typ != "" && tg.typ != def {
+		return 0, fmt.Errorf("field %s: unsupported type %q", x, tg.typ)
+	}
+
+	view := viewOf(order, off)
+	param := "byteorder." + name
+
+	fmt.Fprintf(&b.enc, "%s.Write%s(%s)\n", view, name, convert(param, conv, x))
+	fmt.Fprintf(&b.dec, "%s = %s\n", x, convert(conv, param, view+".Read"+name+"()"))
+
+	return 16, nil //nolint:gomnd
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	dir := filepath.Join("internal", "example")
+
+	golden, err := ioutil.ReadFile(filepath.Join(dir, "header_byteorder.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := ioutil.TempDir("", "byteorder-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(out)
+
+	output := filepath.Join(out, "out.go")
+	if err := run([]string{"-type=Header,Sample", "-order=be", "-output=" + output, dir}); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(golden, actual) {
+		t.Fatalf("generated code is stale, run go generate:\n%s", actual)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := writePackage(t, "type T struct{ A uint32 `byteorder:\"u24\"` }")
+	defer os.RemoveAll(dir)
+
+	if err := run([]string{"-type=T", dir}); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := ioutil.ReadFile(filepath.Join(dir, "t_byteorder.go"))
+	if err != nil || !bytes.Contains(src, []byte("byteorder.LE(b).WriteUint24(v.A)")) {
+		t.Fatalf("unexpected output %s (%v)", src, err)
+	}
+
+	for _, args := range [][]string{
+		{"-unknown"},
+		{dir},
+		{"-type=T", filepath.Join(dir, "missing")},
+		{"-type=T", "-order=me", dir},
+		{"-type=T", "-output=" + filepath.Join(dir, "missing", "t.go"), dir},
+	} {
+		if err := run(args); err == nil {
+			t.Fatalf("%v: expected error", args)
+		}
+	}
+}
+
+func TestGenerateEmpty(t *testing.T) {
+	dir := writePackage(t, "type T struct{ a int; B string `byteorder:\"-\"` }")
+	defer os.RemoveAll(dir)
+
+	g, err := parseDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := g.generate([]string{"T"}, "be")
+	if err != nil || bytes.Contains(src, []byte("b := dst[n:]")) || !bytes.Contains(src, []byte("return 0")) {
+		t.Fatalf("unexpected output %s (%v)", src, err)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := map[string]string{
+		"type T []byte":                                           "not a struct",
+		"type U struct{}":                                         "type T not found",
+		"type T struct{ A []byte }":                               "unsupported slice",
+		"type T struct{ A [n]byte }; const n = 2":                 "unsupported slice",
+		"type T struct{ A [0x1_0000_0000_0000_0000]byte }":        "unsupported array length",
+		"type T struct{ A [2]map[int]int }":                       "unsupported type",
+		"type T struct{ A string }":                               "unsupported type string",
+		"type T struct{ A int }":                                  "requires a width tag",
+		"type T struct{ A int `byteorder:\"i40\"` }":              "portable 32 bit of int",
+		"type T struct{ A uint `byteorder:\"u64\"` }":             "portable 32 bit of uint",
+		"type T struct{ A uint16 `byteorder:\"u24\"` }":           "wider than uint16",
+		"type T struct{ A uint16 `byteorder:\"i16\"` }":           "unsupported type \"i16\"",
+		"type T struct{ A uint16 `byteorder:\"u12\"` }":           "unsupported type \"u12\"",
+		"type T struct{ A uint16 `byteorder:\"u8,u16\"` }":        "duplicate type",
+		"type T struct{ A uint16 `byteorder:\"skip=x\"` }":        "invalid option",
+		"type T struct{ A bool `byteorder:\"u16\"` }":             "unsupported type \"u16\"",
+		"type T struct{ A float32 `byteorder:\"f80\"` }":          "unsupported type \"f80\"",
+		"type T struct{ A struct{} `byteorder:\"u8\"` }":          "for a struct",
+		"type T struct{ *U }; type U struct{}":                    "unsupported type",
+		"type T struct{ A fmt.Stringer }":                         "unsupported type",
+		"type T struct{ byteorder.Uint128 `byteorder:\"i128\"` }": "unsupported type \"i128\"",
+	}
+
+	for src, expected := range tests {
+		dir := writePackage(t, src)
+
+		g, err := parseDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = g.generate([]string{"T"}, "le")
+		os.RemoveAll(dir)
+
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("%s: expected error %q but got %v", src, expected, err)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	dir := writePackage(t, "type T struct{")
+	defer os.RemoveAll(dir)
+
+	if _, err := parseDir(dir); err == nil {
+		t.Fatal("expected syntax error")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "src.go"), []byte("package b\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := parseDir(dir); err == nil || !strings.Contains(err.Error(), "single package") {
+		t.Fatalf("expected package error but got %v", err)
+	}
+}
+
+// writePackage writes src as a package importing byteorder into a new temporary directory.
+func writePackage(t *testing.T, src string) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "byteorder-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src = "package p\n\nimport (\n\t\"fmt\"\n\t\"github.com/worldiety/byteorder\"\n)\n\nvar _ fmt.Stringer\n\n" +
+		"var _ byteorder.Order\n\n" + src + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "src.go"), []byte(src), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func TestGenerateArrayLength(t *testing.T) {
+	dir := writePackage(t, "type T struct{ A [0x2]uint16; B [1_0]byte; C [0o3]bool }")
+	defer os.RemoveAll(dir)
+
+	g, err := parseDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := g.generate([]string{"T"}, "le")
+	if err != nil || !bytes.Contains(src, []byte("return 17")) {
+		t.Fatalf("unexpected output %s (%v)", src, err)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package example contains the types from which the byteorder-gen tests generate their golden output.
+package example
+
+import bo "github.com/worldiety/byteorder"
+
+//go:generate go run github.com/worldiety/byteorder/cmd/byteorder-gen -type=Header,Sample -order=be
+
+// Kind is a named unsigned type.
+type Kind uint16
+
+// Header is a file header with odd width fields.
+type Header struct {
+	Magic   [4]byte
+	Version uint8
+	Kind    Kind
+	Length  uint64  `byteorder:"u40"`
+	Offset  int64   `byteorder:"i48,le"`
+	Gain    float32 `byteorder:"f16"`
+	_       [3]byte
+	Samples [2]Sample
+	ID      bo.Uint128
+	Balance bo.Int128 `byteorder:"le"`
+	Valid   bool
+	Count   int  `byteorder:"i32"`
+	Frames  uint `byteorder:"u24,skip=1"`
+	Tiny    int8
+	Small   int16 `byteorder:"i8"`
+	Ratio   float64
+	Scale   float32      `byteorder:"f64"`
+	Grid    [2][3]uint16 `byteorder:"le"`
+	Comment string       `byteorder:"-"`
+	cache   int
+}
+
+// Sample is an element of Header.
+type Sample struct {
+	Value int32   `byteorder:"i24"`
+	Level float64 `byteorder:"bf16,skip=1"`
+	Raw   uint32  `byteorder:"u32"`
+	Bias  float64 `byteorder:"f32"`
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package example_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/worldiety/byteorder"
+	"github.com/worldiety/byteorder/cmd/byteorder-gen/internal/example"
+)
+
+func newHeader() example.Header {
+	return example.Header{
+		Magic:   [4]byte{'W', 'D', 'Y', 0},
+		Version: 3,
+		Kind:    0xABCD,
+		Length:  byteorder.MaxUint40,
+		Offset:  byteorder.MinInt48,
+		Gain:    -1.5,
+		Samples: [2]example.Sample{
+			{Value: byteorder.MinInt24, Level: 0.5, Raw: math.MaxUint32, Bias: 1.25},
+			{Value: byteorder.MaxInt24, Level: -8, Raw: 1, Bias: -0.75},
+		},
+		ID:      byteorder.Uint128{Hi: 1, Lo: 2},
+		Balance: byteorder.Int128{Hi: -1, Lo: 42},
+		Valid:   true,
+		Count:   int(byteorder.MinInt32),
+		Frames:  uint(byteorder.MaxUint24),
+		Tiny:    -128,
+		Small:   127,
+		Ratio:   math.Pi,
+		Scale:   0.1,
+		Grid:    [2][3]uint16{{1, 2, 3}, {4, 5, 0xFFFF}},
+		Comment: "not encoded",
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	h := newHeader()
+
+	expected, err := byteorder.Marshal(&h, byteorder.Big)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(expected, actual) || h.Size() != len(expected) {
+		t.Fatalf("expected\n%x but got\n%x", expected, actual)
+	}
+
+	prefixed, err := h.AppendBinary([]byte{0xFF})
+	if err != nil || !bytes.Equal(prefixed[1:], expected) || prefixed[0] != 0xFF {
+		t.Fatalf("unexpected append result %x (%v)", prefixed, err)
+	}
+
+	var decoded example.Header
+	if err := decoded.UnmarshalBinary(actual); err != nil {
+		t.Fatal(err)
+	}
+
+	h.Comment = ""
+	if !reflect.DeepEqual(h, decoded) {
+		t.Fatalf("expected %+v but got %+v", h, decoded)
+	}
+
+	if err := decoded.UnmarshalBinary(actual[1:]); !errors.Is(err, byteorder.ErrShortBuffer) {
+		t.Fatalf("expected short buffer but got %v", err)
+	}
+
+	s := h.Samples[0]
+	if b, err := s.MarshalBinary(); err != nil || !bytes.Equal(b, actual[23:23+s.Size()]) {
+		t.Fatalf("unexpected sample encoding %x (%v)", b, err)
+	}
+
+	if err := s.UnmarshalBinary(actual[23+s.Size():]); err != nil || s != h.Samples[1] {
+		t.Fatalf("expected %+v but got %+v (%v)", h.Samples[1], s, err)
+	}
+
+	if err := s.UnmarshalBinary(nil); !errors.Is(err, byteorder.ErrShortBuffer) {
+		t.Fatalf("expected short buffer but got %v", err)
+	}
+}
+
+func TestGeneratedOverflow(t *testing.T) {
+	for _, modify := range []func(h *example.Header){
+		func(h *example.Header) { h.Length = byteorder.MaxUint40 + 1 },
+		func(h *example.Header) { h.Offset = byteorder.MaxInt48 + 1 },
+		func(h *example.Header) { h.Samples[1].Value = byteorder.MinInt24 - 1 },
+		func(h *example.Header) { h.Frames = uint(byteorder.MaxUint24) + 1 },
+		func(h *example.Header) { h.Small = -129 },
+	} {
+		h := newHeader()
+		modify(&h)
+
+		if b, err := h.AppendBinary([]byte{1}); !errors.Is(err, byteorder.ErrOverflow) || !bytes.Equal(b, []byte{1}) {
+			t.Fatalf("expected overflow but got %x (%v)", b, err)
+		}
+	}
+
+	if byteorder.UintSize == 64 {
+		count := int64(byteorder.MaxInt32) + 1
+		h := newHeader()
+		h.Count = int(count)
+
+		if _, err := h.MarshalBinary(); !errors.Is(err, byteorder.ErrOverflow) {
+			t.Fatalf("expected overflow but got %v", err)
+		}
+	}
+
+	s := example.Sample{Value: byteorder.MaxInt24 + 1}
+	if _, err := s.MarshalBinary(); !errors.Is(err, byteorder.ErrOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+}
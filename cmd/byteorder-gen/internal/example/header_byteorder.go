@@ -0,0 +1,154 @@
+// Code generated by byteorder-gen; DO NOT EDIT.
+
+package example
+
+import "github.com/worldiety/byteorder"
+
+// Size returns the encoded size of Header in bytes.
+func (v *Header) Size() int {
+	return 122
+}
+
+// AppendBinary appends the big-endian encoding of v to dst. Returns a byteorder.OverflowError if a value does not
+// fit into its tagged width.
+func (v *Header) AppendBinary(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = append(dst, make([]byte, 122)...)
+	b := dst[n:]
+	for i0 := 0; i0 < 4; i0++ {
+		b[i0] = v.Magic[i0]
+	}
+	b[4] = v.Version
+	byteorder.BE(b[5:]).WriteUint16(uint16(v.Kind))
+	if v.Length > uint64(byteorder.MaxUint40) {
+		return dst[:n], &byteorder.OverflowError{Value: v.Length, Width: 40}
+	}
+	byteorder.BE(b[7:]).WriteUint40(v.Length)
+	if v.Offset < int64(byteorder.MinInt48) || v.Offset > int64(byteorder.MaxInt48) {
+		return dst[:n], &byteorder.OverflowError{Value: v.Offset, Width: 48}
+	}
+	byteorder.LE(b[12:]).WriteInt48(v.Offset)
+	byteorder.BE(b[18:]).WriteFloat16(v.Gain)
+	for i0 := 0; i0 < 2; i0++ {
+		if int64(v.Samples[i0].Value) < int64(byteorder.MinInt24) || int64(v.Samples[i0].Value) > int64(byteorder.MaxInt24) {
+			return dst[:n], &byteorder.OverflowError{Value: v.Samples[i0].Value, Width: 24}
+		}
+		byteorder.BE(b[23+i0*14:]).WriteInt24(v.Samples[i0].Value)
+		byteorder.BE(b[23+i0*14+4:]).WriteBFloat16(float32(v.Samples[i0].Level))
+		byteorder.BE(b[23+i0*14+6:]).WriteUint32(v.Samples[i0].Raw)
+		byteorder.BE(b[23+i0*14+10:]).WriteFloat32(float32(v.Samples[i0].Bias))
+	}
+	byteorder.BE(b[51:]).WriteUint128(v.ID)
+	byteorder.LE(b[67:]).WriteInt128(v.Balance)
+	if v.Valid {
+		b[83] = 1
+	}
+	if int64(v.Count) < int64(byteorder.MinInt32) || int64(v.Count) > int64(byteorder.MaxInt32) {
+		return dst[:n], &byteorder.OverflowError{Value: v.Count, Width: 32}
+	}
+	byteorder.BE(b[84:]).WriteInt32(int32(v.Count))
+	if uint64(v.Frames) > uint64(byteorder.MaxUint24) {
+		return dst[:n], &byteorder.OverflowError{Value: v.Frames, Width: 24}
+	}
+	byteorder.BE(b[89:]).WriteUint24(uint32(v.Frames))
+	b[92] = uint8(v.Tiny)
+	if int64(v.Small) < int64(byteorder.MinInt8) || int64(v.Small) > int64(byteorder.MaxInt8) {
+		return dst[:n], &byteorder.OverflowError{Value: v.Small, Width: 8}
+	}
+	b[93] = uint8(v.Small)
+	byteorder.BE(b[94:]).WriteFloat64(v.Ratio)
+	byteorder.BE(b[102:]).WriteFloat64(float64(v.Scale))
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 3; i1++ {
+			byteorder.LE(b[110+i0*6+i1*2:]).WriteUint16(v.Grid[i0][i1])
+		}
+	}
+
+	return dst, nil
+}
+
+// MarshalBinary returns the big-endian encoding of v.
+func (v *Header) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 122))
+}
+
+// UnmarshalBinary decodes the first 122 bytes of b in big-endian order into v. Returns a byteorder.ShortBufferError
+// if b is too small.
+func (v *Header) UnmarshalBinary(b []byte) error {
+	if len(b) < 122 {
+		return &byteorder.ShortBufferError{Needed: 122, Available: len(b)}
+	}
+
+	for i0 := 0; i0 < 4; i0++ {
+		v.Magic[i0] = b[i0]
+	}
+	v.Version = b[4]
+	v.Kind = Kind(byteorder.BE(b[5:]).ReadUint16())
+	v.Length = byteorder.BE(b[7:]).ReadUint40()
+	v.Offset = byteorder.LE(b[12:]).ReadInt48()
+	v.Gain = byteorder.BE(b[18:]).ReadFloat16()
+	for i0 := 0; i0 < 2; i0++ {
+		v.Samples[i0].Value = byteorder.BE(b[23+i0*14:]).ReadInt24()
+		v.Samples[i0].Level = float64(byteorder.BE(b[23+i0*14+4:]).ReadBFloat16())
+		v.Samples[i0].Raw = byteorder.BE(b[23+i0*14+6:]).ReadUint32()
+		v.Samples[i0].Bias = float64(byteorder.BE(b[23+i0*14+10:]).ReadFloat32())
+	}
+	v.ID = byteorder.BE(b[51:]).ReadUint128()
+	v.Balance = byteorder.LE(b[67:]).ReadInt128()
+	v.Valid = b[83] != 0
+	v.Count = int(byteorder.BE(b[84:]).ReadInt32())
+	v.Frames = uint(byteorder.BE(b[89:]).ReadUint24())
+	v.Tiny = int8(b[92])
+	v.Small = int16(int8(b[93]))
+	v.Ratio = byteorder.BE(b[94:]).ReadFloat64()
+	v.Scale = float32(byteorder.BE(b[102:]).ReadFloat64())
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 3; i1++ {
+			v.Grid[i0][i1] = byteorder.LE(b[110+i0*6+i1*2:]).ReadUint16()
+		}
+	}
+
+	return nil
+}
+
+// Size returns the encoded size of Sample in bytes.
+func (v *Sample) Size() int {
+	return 14
+}
+
+// AppendBinary appends the big-endian encoding of v to dst. Returns a byteorder.OverflowError if a value does not
+// fit into its tagged width.
+func (v *Sample) AppendBinary(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = append(dst, make([]byte, 14)...)
+	b := dst[n:]
+	if int64(v.Value) < int64(byteorder.MinInt24) || int64(v.Value) > int64(byteorder.MaxInt24) {
+		return dst[:n], &byteorder.OverflowError{Value: v.Value, Width: 24}
+	}
+	byteorder.BE(b).WriteInt24(v.Value)
+	byteorder.BE(b[4:]).WriteBFloat16(float32(v.Level))
+	byteorder.BE(b[6:]).WriteUint32(v.Raw)
+	byteorder.BE(b[10:]).WriteFloat32(float32(v.Bias))
+
+	return dst, nil
+}
+
+// MarshalBinary returns the big-endian encoding of v.
+func (v *Sample) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 14))
+}
+
+// UnmarshalBinary decodes the first 14 bytes of b in big-endian order into v. Returns a byteorder.ShortBufferError
+// if b is too small.
+func (v *Sample) UnmarshalBinary(b []byte) error {
+	if len(b) < 14 {
+		return &byteorder.ShortBufferError{Needed: 14, Available: len(b)}
+	}
+
+	v.Value = byteorder.BE(b).ReadInt24()
+	v.Level = float64(byteorder.BE(b[4:]).ReadBFloat16())
+	v.Raw = byteorder.BE(b[6:]).ReadUint32()
+	v.Bias = float64(byteorder.BE(b[10:]).ReadFloat32())
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command byteorder-gen generates allocation-free encoders for struct types, which are tagged like for
+// byteorder.Marshal. For each type, it emits the Size, AppendBinary, MarshalBinary and UnmarshalBinary methods,
+// which call the LittleEndian and BigEndian methods directly instead of using reflection. Unsupported fields are
+// reported at generation time. Usage:
+//
+//	//go:generate byteorder-gen -type=Header,Record -order=be
+//
+// The flags are:
+//
+//	-type    comma separated list of struct type names, required
+//	-order   default byte order of all fields without an explicit order, either le or be (default le)
+//	-output  output file name (default <first type in lower case>_byteorder.go)
+//
+// The package is read from the directory given as argument or from the current directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("byteorder-gen: ")
+
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run parses the arguments, generates the methods and writes the output file.
+func run(args []string) error {
+	flags := flag.NewFlagSet("byteorder-gen", flag.ContinueOnError)
+	typeNames := flags.String("type", "", "comma separated list of struct type names, required")
+	order := flags.String("order", "le", "default byte order, either le or be")
+	output := flags.String("output", "", "output file name (default <first type in lower case>_byteorder.go)")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *typeNames == "" {
+		return fmt.Errorf("flag -type is required")
+	}
+
+	dir := "."
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+
+	g, err := parseDir(dir)
+	if err != nil {
+		return err
+	}
+
+	names := strings.Split(*typeNames, ",")
+
+	src, err := g.generate(names, *order)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = filepath.Join(dir, strings.ToLower(names[0])+"_byteorder.go")
+	}
+
+	return ioutil.WriteFile(*output, src, 0o644) //nolint:gosec,gomnd
+}
@@ -1,6 +1,7 @@
 package byteorder_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -123,3 +124,155 @@ func Test_Float64(t *testing.T) {
 	BE(tmp).WriteFloat64(BE(src).ReadFloat64())
 	assertValues(t, tmp)
 }
+
+func Test_Int16(t *testing.T) {
+	tmp := make([]byte, 2)
+
+	LE(tmp).WriteInt16(LE(src).ReadInt16())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt16(BE(src).ReadInt16())
+	assertValues(t, tmp)
+
+	LE(tmp).WriteInt16(MinInt16)
+	if v := LE(tmp).ReadInt16(); v != MinInt16 {
+		t.Fatalf("expected %d but got %d", MinInt16, v)
+	}
+}
+
+func Test_Int24(t *testing.T) {
+	tmp := make([]byte, 3)
+
+	LE(tmp).WriteInt24(LE(src).ReadInt24())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt24(BE(src).ReadInt24())
+	assertValues(t, tmp)
+
+	for _, v := range []int32{MinInt24, -1, 0, 1, MaxInt24} {
+		LE(tmp).WriteInt24(v)
+		if r := LE(tmp).ReadInt24(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+
+		BE(tmp).WriteInt24(v)
+		if r := BE(tmp).ReadInt24(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+	}
+
+	assertOverflow(t, func() { LE(tmp).WriteInt24(MaxInt24 + 1) })
+	assertOverflow(t, func() { BE(tmp).WriteInt24(MinInt24 - 1) })
+}
+
+func Test_Int32(t *testing.T) {
+	tmp := make([]byte, 4)
+
+	LE(tmp).WriteInt32(LE(src).ReadInt32())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt32(BE(src).ReadInt32())
+	assertValues(t, tmp)
+}
+
+func Test_Int40(t *testing.T) {
+	tmp := make([]byte, 5)
+
+	LE(tmp).WriteInt40(LE(src).ReadInt40())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt40(BE(src).ReadInt40())
+	assertValues(t, tmp)
+
+	for _, v := range []int64{MinInt40, -1, 0, 1, MaxInt40} {
+		LE(tmp).WriteInt40(v)
+		if r := LE(tmp).ReadInt40(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+
+		BE(tmp).WriteInt40(v)
+		if r := BE(tmp).ReadInt40(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+	}
+
+	assertOverflow(t, func() { LE(tmp).WriteInt40(MaxInt40 + 1) })
+	assertOverflow(t, func() { BE(tmp).WriteInt40(MinInt40 - 1) })
+}
+
+func Test_Int48(t *testing.T) {
+	tmp := make([]byte, 6)
+
+	LE(tmp).WriteInt48(LE(src).ReadInt48())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt48(BE(src).ReadInt48())
+	assertValues(t, tmp)
+
+	for _, v := range []int64{MinInt48, -1, 0, 1, MaxInt48} {
+		LE(tmp).WriteInt48(v)
+		if r := LE(tmp).ReadInt48(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+
+		BE(tmp).WriteInt48(v)
+		if r := BE(tmp).ReadInt48(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+	}
+
+	assertOverflow(t, func() { LE(tmp).WriteInt48(MaxInt48 + 1) })
+	assertOverflow(t, func() { BE(tmp).WriteInt48(MinInt48 - 1) })
+}
+
+func Test_Int56(t *testing.T) {
+	tmp := make([]byte, 7)
+
+	LE(tmp).WriteInt56(LE(src).ReadInt56())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt56(BE(src).ReadInt56())
+	assertValues(t, tmp)
+
+	for _, v := range []int64{MinInt56, -1, 0, 1, MaxInt56} {
+		LE(tmp).WriteInt56(v)
+		if r := LE(tmp).ReadInt56(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+
+		BE(tmp).WriteInt56(v)
+		if r := BE(tmp).ReadInt56(); r != v {
+			t.Fatalf("expected %d but got %d", v, r)
+		}
+	}
+
+	assertOverflow(t, func() { LE(tmp).WriteInt56(MaxInt56 + 1) })
+	assertOverflow(t, func() { BE(tmp).WriteInt56(MinInt56 - 1) })
+}
+
+func Test_Int64(t *testing.T) {
+	tmp := make([]byte, 8)
+
+	LE(tmp).WriteInt64(LE(src).ReadInt64())
+	assertValues(t, tmp)
+
+	BE(tmp).WriteInt64(BE(src).ReadInt64())
+	assertValues(t, tmp)
+
+	if v := LE(src).ReadInt64(); v >= 0 {
+		t.Fatalf("expected negative value but got %d", v)
+	}
+}
+
+func assertOverflow(t *testing.T, f func()) {
+	t.Helper()
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrOverflow) {
+			t.Fatalf("expected overflow panic but got %v", err)
+		}
+	}()
+
+	f()
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOverflow is the sentinel for any OverflowError and can be matched using errors.Is.
+var ErrOverflow = errors.New("value overflow")
+
+// OverflowError is raised if a value cannot be represented with the requested amount of bits.
+type OverflowError struct {
+	// Value is the offending value as passed by the caller.
+	Value interface{}
+
+	// Width is the size of the encoding in bits.
+	Width int
+}
+
+// Error returns a description including the offending value and the width.
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("byteorder: value %v overflows %d bit", e.Value, e.Width)
+}
+
+// Is returns true if target is ErrOverflow.
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// ErrShortBuffer is the sentinel for any ShortBufferError and can be matched using errors.Is.
+var ErrShortBuffer = errors.New("short buffer")
+
+// ShortBufferError is returned if a buffer is too small to read or write a value.
+type ShortBufferError struct {
+	// Needed is the amount of bytes required by the operation.
+	Needed int
+
+	// Available is the actual amount of bytes.
+	Available int
+}
+
+// Error returns a description including the needed and available lengths.
+func (e *ShortBufferError) Error() string {
+	return fmt.Sprintf("byteorder: short buffer, needed %d bytes but only %d available", e.Needed, e.Available)
+}
+
+// Is returns true if target is ErrShortBuffer.
+func (e *ShortBufferError) Is(target error) bool {
+	return target == ErrShortBuffer
+}
+
+// ErrVarintOverflow is returned if a LEB128 encoded value does not fit into 64 bit or is longer than
+// MaxVarintLen64 bytes.
+var ErrVarintOverflow = errors.New("varint overflow")
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestOverflowError(t *testing.T) {
+	err := error(&OverflowError{Value: int64(1) << 40, Width: 40})
+
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected %v to be ErrOverflow", err)
+	}
+
+	if err.Error() != "byteorder: value 1099511627776 overflows 40 bit" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
+
+func TestShortBufferError(t *testing.T) {
+	err := error(&ShortBufferError{Needed: 3, Available: 2})
+
+	if !errors.Is(err, ErrShortBuffer) {
+		t.Fatalf("expected %v to be ErrShortBuffer", err)
+	}
+
+	if err.Error() != "byteorder: short buffer, needed 3 bytes but only 2 available" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
@@ -0,0 +1,370 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"math"
+	"math/big"
+)
+
+const (
+	// extBias is the exponent bias of the x87 extended and the binary128 format, which both use 15 bit.
+	extBias = 16383
+	// extMaxExp is the biased exponent of infinities and NaNs.
+	extMaxExp = 0x7fff
+	// float80Prec is the precision of the x87 extended format, which stores the leading integer bit explicitly.
+	float80Prec = 64
+	// float128Prec is the precision of the binary128 format including its implicit leading bit.
+	float128Prec = 113
+)
+
+// newExtFloat returns the exact value of a finite float with a 15 bit exponent. n is the significand including
+// the leading bit and prec its precision.
+func newExtFloat(neg bool, biased int, n *big.Int, prec uint) *big.Float {
+	if biased == 0 {
+		biased = 1 // subnormals share the exponent of the smallest normal
+	}
+
+	f := new(big.Float).SetPrec(prec).SetInt(n)
+	f.SetMantExp(f, biased-extBias-int(prec-1))
+
+	if neg {
+		f.Neg(f)
+	}
+
+	return f
+}
+
+// roundExtFloat rounds the finite x to a significand n with the given precision and a biased 15 bit exponent,
+// ties to even. A subnormal result has a biased exponent of 0 and an overflow is reported as extMaxExp. The
+// accuracy is reported with respect to x.
+func roundExtFloat(x *big.Float, prec uint) (n *big.Int, biased int, acc big.Accuracy) {
+	abs := new(big.Float).Abs(x)
+	if abs.Sign() == 0 {
+		return new(big.Int), 0, big.Exact
+	}
+
+	e := abs.MantExp(nil)
+
+	exp := e - 1
+	if exp < 1-extBias {
+		exp = 1 - extBias
+	}
+
+	// scale, so that the unit in the last place becomes 1 and round to an integer
+	s := new(big.Float).SetMantExp(abs, int(prec)-1-exp)
+	k := s.MantExp(nil)
+
+	switch {
+	case k >= 1:
+		s.SetMode(big.ToNearestEven).SetPrec(uint(k))
+		acc = s.Acc()
+		n, _ = s.Int(nil)
+	case k == 0 && s.Cmp(big.NewFloat(0.5)) > 0:
+		n, acc = big.NewInt(1), big.Above
+	default:
+		n, acc = new(big.Int), big.Below
+	}
+
+	// the rounding may carry into the next binade
+	if n.BitLen() > int(prec) {
+		n.Rsh(n, 1)
+		exp++
+	}
+
+	if n.BitLen() == int(prec) {
+		biased = exp + extBias
+	}
+
+	if biased >= extMaxExp {
+		n, biased, acc = new(big.Int), extMaxExp, big.Above
+	}
+
+	if x.Signbit() {
+		acc = -acc
+	}
+
+	return n, biased, acc
+}
+
+// float64Of converts f to the nearest float64. A nil f is converted into a quiet NaN, whose fraction is made of
+// the upper 52 bit of the most significant bit aligned payload.
+func float64Of(f *big.Float, neg bool, payload uint64) (float64, big.Accuracy) {
+	if f == nil {
+		bits := uint64(0x7ff8)<<48 | payload>>12
+		if neg {
+			bits |= 1 << 63
+		}
+
+		return math.Float64frombits(bits), big.Exact
+	}
+
+	return f.Float64()
+}
+
+// bigOf returns v as big.Float or nil if v is NaN.
+func bigOf(v float64) *big.Float {
+	if math.IsNaN(v) {
+		return nil
+	}
+
+	return big.NewFloat(v)
+}
+
+// nanPayload returns the 52 bit fraction of v, aligned to the most significant bit of an uint64.
+func nanPayload(v float64) uint64 {
+	return math.Float64bits(v) << 12
+}
+
+// float80 decodes the sign and exponent se and the significand m. Returns nil for NaN.
+func float80(se uint16, m uint64) *big.Float {
+	neg := se&0x8000 != 0
+	biased := int(se & extMaxExp)
+
+	if biased == extMaxExp {
+		if m<<1 != 0 {
+			return nil
+		}
+
+		return new(big.Float).SetInf(neg)
+	}
+
+	return newExtFloat(neg, biased, new(big.Int).SetUint64(m), float80Prec)
+}
+
+// float80bits encodes x into the sign and exponent se and the significand m. A nil x is encoded as a quiet NaN
+// with the most significant bit aligned payload.
+func float80bits(x *big.Float, neg bool, payload uint64) (se uint16, m uint64, acc big.Accuracy) {
+	if neg {
+		se = 0x8000
+	}
+
+	switch {
+	case x == nil:
+		return se | extMaxExp, 1<<63 | 1<<62 | payload>>1, big.Exact
+	case x.IsInf():
+		return se | extMaxExp, 1 << 63, big.Exact
+	}
+
+	n, biased, acc := roundExtFloat(x, float80Prec)
+	if biased == extMaxExp {
+		return se | extMaxExp, 1 << 63, acc
+	}
+
+	return se | uint16(biased), n.Uint64(), acc
+}
+
+// float128 decodes the upper and lower 64 bit. Returns nil for NaN.
+func float128(hi, lo uint64) *big.Float {
+	neg := hi>>63 != 0
+	biased := int(hi>>48) & extMaxExp
+	frac := hi & (1<<48 - 1)
+
+	if biased == extMaxExp {
+		if frac|lo != 0 {
+			return nil
+		}
+
+		return new(big.Float).SetInf(neg)
+	}
+
+	if biased != 0 {
+		frac |= 1 << 48
+	}
+
+	n := new(big.Int).SetUint64(frac)
+	n.Lsh(n, 64).Or(n, new(big.Int).SetUint64(lo))
+
+	return newExtFloat(neg, biased, n, float128Prec)
+}
+
+// float128bits encodes x into the upper and lower 64 bit. A nil x is encoded as a quiet NaN with the most
+// significant bit aligned payload.
+func float128bits(x *big.Float, neg bool, payload uint64) (hi, lo uint64, acc big.Accuracy) {
+	if neg {
+		hi = 1 << 63
+	}
+
+	switch {
+	case x == nil:
+		return hi | extMaxExp<<48 | 1<<47 | payload>>16, payload << 48, big.Exact
+	case x.IsInf():
+		return hi | extMaxExp<<48, 0, big.Exact
+	}
+
+	n, biased, acc := roundExtFloat(x, float128Prec)
+	lo = new(big.Int).And(n, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	hi |= uint64(biased)<<48 | new(big.Int).Rsh(n, 64).Uint64()&(1<<48-1)
+
+	return hi, lo, acc
+}
+
+// ReadFloat80 reads 10 bytes of an x87 80 bit extended precision float and converts it to the nearest float64.
+// The accuracy reports whether the result is exact or has been rounded towards zero or infinity, e.g. due to the
+// 11 bits of lost precision or the smaller exponent range. NaNs keep the upper bits of their payload.
+// Panics when len(b) < 10.
+func (b LittleEndian) ReadFloat80() (float64, big.Accuracy) {
+	se, m := LittleEndian(b[8:]).ReadUint16(), b.ReadUint64()
+
+	return float64Of(float80(se, m), se&0x8000 != 0, m<<1)
+}
+
+// ReadFloat80Big reads 10 bytes of an x87 80 bit extended precision float and returns its exact value, which
+// may also be an infinity. Returns nil for a NaN, which cannot be represented by a big.Float.
+// Panics when len(b) < 10.
+func (b LittleEndian) ReadFloat80Big() *big.Float {
+	se, m := LittleEndian(b[8:]).ReadUint16(), b.ReadUint64()
+
+	return float80(se, m)
+}
+
+// WriteFloat80 writes v as an x87 80 bit extended precision float, which represents every float64 exactly.
+// Panics when len(b) < 10.
+func (b LittleEndian) WriteFloat80(v float64) {
+	se, m, _ := float80bits(bigOf(v), math.Signbit(v), nanPayload(v))
+	_ = b[9] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(m)
+	LittleEndian(b[8:]).WriteUint16(se)
+}
+
+// WriteFloat80Big writes x rounded to the nearest x87 80 bit extended precision float, ties to even. The accuracy
+// reports whether the written value is exact or has been rounded. Panics when len(b) < 10 or if x is nil.
+func (b LittleEndian) WriteFloat80Big(x *big.Float) big.Accuracy {
+	se, m, acc := float80bits(x, x.Signbit(), 0)
+	_ = b[9] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(m)
+	LittleEndian(b[8:]).WriteUint16(se)
+
+	return acc
+}
+
+// ReadFloat128 reads 16 bytes of an IEEE 754 binary128 (quadruple precision) float and converts it to the
+// nearest float64. The accuracy reports whether the result is exact or has been rounded towards zero or infinity.
+// NaNs keep the upper bits of their payload. Panics when len(b) < 16.
+func (b LittleEndian) ReadFloat128() (float64, big.Accuracy) {
+	hi, lo := LittleEndian(b[8:]).ReadUint64(), b.ReadUint64()
+
+	return float64Of(float128(hi, lo), hi>>63 != 0, hi<<16|lo>>48)
+}
+
+// ReadFloat128Big reads 16 bytes of an IEEE 754 binary128 (quadruple precision) float and returns its exact
+// value, which may also be an infinity. Returns nil for a NaN, which cannot be represented by a big.Float.
+// Panics when len(b) < 16.
+func (b LittleEndian) ReadFloat128Big() *big.Float {
+	hi, lo := LittleEndian(b[8:]).ReadUint64(), b.ReadUint64()
+
+	return float128(hi, lo)
+}
+
+// WriteFloat128 writes v as an IEEE 754 binary128 (quadruple precision) float, which represents every float64
+// exactly. Panics when len(b) < 16.
+func (b LittleEndian) WriteFloat128(v float64) {
+	hi, lo, _ := float128bits(bigOf(v), math.Signbit(v), nanPayload(v))
+	_ = b[15] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(lo)
+	LittleEndian(b[8:]).WriteUint64(hi)
+}
+
+// WriteFloat128Big writes x rounded to the nearest IEEE 754 binary128 (quadruple precision) float, ties to even.
+// The accuracy reports whether the written value is exact or has been rounded. Panics when len(b) < 16 or if x
+// is nil.
+func (b LittleEndian) WriteFloat128Big(x *big.Float) big.Accuracy {
+	hi, lo, acc := float128bits(x, x.Signbit(), 0)
+	_ = b[15] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(lo)
+	LittleEndian(b[8:]).WriteUint64(hi)
+
+	return acc
+}
+
+// ReadFloat80 reads 10 bytes of an x87 80 bit extended precision float and converts it to the nearest float64.
+// The accuracy reports whether the result is exact or has been rounded towards zero or infinity, e.g. due to the
+// 11 bits of lost precision or the smaller exponent range. NaNs keep the upper bits of their payload.
+// Panics when len(b) < 10.
+func (b BigEndian) ReadFloat80() (float64, big.Accuracy) {
+	se, m := b.ReadUint16(), BigEndian(b[2:]).ReadUint64()
+
+	return float64Of(float80(se, m), se&0x8000 != 0, m<<1)
+}
+
+// ReadFloat80Big reads 10 bytes of an x87 80 bit extended precision float and returns its exact value, which
+// may also be an infinity. Returns nil for a NaN, which cannot be represented by a big.Float.
+// Panics when len(b) < 10.
+func (b BigEndian) ReadFloat80Big() *big.Float {
+	se, m := b.ReadUint16(), BigEndian(b[2:]).ReadUint64()
+
+	return float80(se, m)
+}
+
+// WriteFloat80 writes v as an x87 80 bit extended precision float, which represents every float64 exactly.
+// Panics when len(b) < 10.
+func (b BigEndian) WriteFloat80(v float64) {
+	se, m, _ := float80bits(bigOf(v), math.Signbit(v), nanPayload(v))
+	_ = b[9] // early bounds check to guarantee safety of writes below
+	b.WriteUint16(se)
+	BigEndian(b[2:]).WriteUint64(m)
+}
+
+// WriteFloat80Big writes x rounded to the nearest x87 80 bit extended precision float, ties to even. The accuracy
+// reports whether the written value is exact or has been rounded. Panics when len(b) < 10 or if x is nil.
+func (b BigEndian) WriteFloat80Big(x *big.Float) big.Accuracy {
+	se, m, acc := float80bits(x, x.Signbit(), 0)
+	_ = b[9] // early bounds check to guarantee safety of writes below
+	b.WriteUint16(se)
+	BigEndian(b[2:]).WriteUint64(m)
+
+	return acc
+}
+
+// ReadFloat128 reads 16 bytes of an IEEE 754 binary128 (quadruple precision) float and converts it to the
+// nearest float64. The accuracy reports whether the result is exact or has been rounded towards zero or infinity.
+// NaNs keep the upper bits of their payload. Panics when len(b) < 16.
+func (b BigEndian) ReadFloat128() (float64, big.Accuracy) {
+	hi, lo := b.ReadUint64(), BigEndian(b[8:]).ReadUint64()
+
+	return float64Of(float128(hi, lo), hi>>63 != 0, hi<<16|lo>>48)
+}
+
+// ReadFloat128Big reads 16 bytes of an IEEE 754 binary128 (quadruple precision) float and returns its exact
+// value, which may also be an infinity. Returns nil for a NaN, which cannot be represented by a big.Float.
+// Panics when len(b) < 16.
+func (b BigEndian) ReadFloat128Big() *big.Float {
+	hi, lo := b.ReadUint64(), BigEndian(b[8:]).ReadUint64()
+
+	return float128(hi, lo)
+}
+
+// WriteFloat128 writes v as an IEEE 754 binary128 (quadruple precision) float, which represents every float64
+// exactly. Panics when len(b) < 16.
+func (b BigEndian) WriteFloat128(v float64) {
+	hi, lo, _ := float128bits(bigOf(v), math.Signbit(v), nanPayload(v))
+	_ = b[15] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(hi)
+	BigEndian(b[8:]).WriteUint64(lo)
+}
+
+// WriteFloat128Big writes x rounded to the nearest IEEE 754 binary128 (quadruple precision) float, ties to even.
+// The accuracy reports whether the written value is exact or has been rounded. Panics when len(b) < 16 or if x
+// is nil.
+func (b BigEndian) WriteFloat128Big(x *big.Float) big.Accuracy {
+	hi, lo, acc := float128bits(x, x.Signbit(), 0)
+	_ = b[15] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(hi)
+	BigEndian(b[8:]).WriteUint64(lo)
+
+	return acc
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+type extFloat interface {
+	ReadFloat80() (float64, big.Accuracy)
+	ReadFloat80Big() *big.Float
+	WriteFloat80(v float64)
+	WriteFloat80Big(x *big.Float) big.Accuracy
+	ReadFloat128() (float64, big.Accuracy)
+	ReadFloat128Big() *big.Float
+	WriteFloat128(v float64)
+	WriteFloat128Big(x *big.Float) big.Accuracy
+}
+
+// pow2 returns m * 2^exp.
+func pow2(m float64, exp int) *big.Float {
+	f := big.NewFloat(m)
+
+	return f.SetMantExp(f, exp)
+}
+
+func TestFloat80AIFF(t *testing.T) {
+	rate := []byte{0x40, 0x0E, 0xAC, 0x44, 0, 0, 0, 0, 0, 0}
+
+	if v, acc := BE(rate).ReadFloat80(); v != 44100 || acc != big.Exact {
+		t.Fatalf("expected 44100 but got %v (%v)", v, acc)
+	}
+
+	tmp := make([]byte, 10)
+	BE(tmp).WriteFloat80(44100)
+
+	if !bytes.Equal(tmp, rate) {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	LE(tmp).WriteFloat80(44100)
+
+	if LE(tmp).ReadUint64() != 0xAC44000000000000 || LE(tmp[8:]).ReadUint16() != 0x400E {
+		t.Fatalf("unexpected little-endian encoding %x", tmp)
+	}
+}
+
+func TestFloat128Layout(t *testing.T) {
+	tmp := make([]byte, 16)
+
+	BE(tmp).WriteFloat128(-2)
+	if BE(tmp).ReadUint64() != 0xC000000000000000 || BE(tmp[8:]).ReadUint64() != 0 {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	LE(tmp).WriteFloat128(1 + 1.0/(1<<52))
+	if LE(tmp[8:]).ReadUint64() != 0x3FFF000000000000 || LE(tmp).ReadUint64() != 1<<60 {
+		t.Fatalf("unexpected little-endian encoding %x", tmp)
+	}
+
+	// the smallest subnormal
+	LE(tmp).WriteUint64(1)
+	LE(tmp[8:]).WriteUint64(0)
+
+	if f := LE(tmp).ReadFloat128Big(); f.Cmp(pow2(1, -16494)) != 0 {
+		t.Fatalf("unexpected smallest subnormal %v", f)
+	}
+}
+
+func TestExtFloatRoundTrip(t *testing.T) {
+	values := []float64{
+		0, math.Copysign(0, -1), 1, -1.5, math.Pi, math.MaxFloat64, -math.SmallestNonzeroFloat64,
+		math.Inf(1), math.Inf(-1), math.Float64frombits(0x7ff8000000000123), math.Float64frombits(0xfff8000000000001),
+	}
+
+	tmp := make([]byte, 16)
+
+	for _, o := range []extFloat{LE(tmp), BE(tmp)} {
+		for _, v := range values {
+			o.WriteFloat80(v)
+			if r, acc := o.ReadFloat80(); math.Float64bits(r) != math.Float64bits(v) || acc != big.Exact {
+				t.Fatalf("float80: expected %v but got %v (%v)", v, r, acc)
+			}
+
+			o.WriteFloat128(v)
+			if r, acc := o.ReadFloat128(); math.Float64bits(r) != math.Float64bits(v) || acc != big.Exact {
+				t.Fatalf("float128: expected %v but got %v (%v)", v, r, acc)
+			}
+		}
+
+		// signaling NaNs become quiet
+		o.WriteFloat80(math.Float64frombits(0x7ff0000000000001))
+		if r, _ := o.ReadFloat80(); math.Float64bits(r) != 0x7ff8000000000001 {
+			t.Fatalf("float80: unexpected NaN %x", math.Float64bits(r))
+		}
+
+		if o.ReadFloat80Big() != nil {
+			t.Fatalf("float80: expected nil for NaN")
+		}
+
+		o.WriteFloat128(math.NaN())
+		if o.ReadFloat128Big() != nil {
+			t.Fatalf("float128: expected nil for NaN")
+		}
+	}
+}
+
+func TestExtFloatPrecisionLoss(t *testing.T) {
+	tmp := make([]byte, 16)
+
+	for _, o := range []extFloat{LE(tmp), BE(tmp)} {
+		tests := []struct {
+			x   *big.Float
+			v   float64
+			acc big.Accuracy
+		}{
+			{new(big.Float).SetPrec(200).Add(big.NewFloat(1), pow2(1, -60)), 1, big.Below},
+			{new(big.Float).SetPrec(200).Sub(big.NewFloat(-1), pow2(1, -60)), -1, big.Above},
+			{pow2(1, 16000), math.Inf(1), big.Above},
+			{pow2(-1, 16000), math.Inf(-1), big.Below},
+			{pow2(1, -16000), 0, big.Below},
+			{pow2(-1, -16000), math.Copysign(0, -1), big.Above},
+			{new(big.Float).SetInf(true), math.Inf(-1), big.Exact},
+		}
+
+		for _, tt := range tests {
+			if acc := o.WriteFloat80Big(tt.x); acc != big.Exact {
+				t.Fatalf("float80: %v must be exact but is %v", tt.x, acc)
+			}
+
+			if v, acc := o.ReadFloat80(); math.Float64bits(v) != math.Float64bits(tt.v) || acc != tt.acc {
+				t.Fatalf("float80: expected %v (%v) but got %v (%v)", tt.v, tt.acc, v, acc)
+			}
+
+			if o.ReadFloat80Big().Cmp(tt.x) != 0 {
+				t.Fatalf("float80: expected exact value %v", tt.x)
+			}
+
+			if acc := o.WriteFloat128Big(tt.x); acc != big.Exact {
+				t.Fatalf("float128: %v must be exact but is %v", tt.x, acc)
+			}
+
+			if v, acc := o.ReadFloat128(); math.Float64bits(v) != math.Float64bits(tt.v) || acc != tt.acc {
+				t.Fatalf("float128: expected %v (%v) but got %v (%v)", tt.v, tt.acc, v, acc)
+			}
+
+			if o.ReadFloat128Big().Cmp(tt.x) != 0 {
+				t.Fatalf("float128: expected exact value %v", tt.x)
+			}
+		}
+	}
+}
+
+func TestExtFloatRounding(t *testing.T) {
+	tmp := make([]byte, 16)
+
+	sum := func(a, b *big.Float) *big.Float { return new(big.Float).SetPrec(1000).Add(a, b) }
+	one := big.NewFloat(1)
+	minNormal := pow2(1, -16382)
+
+	tests := []struct {
+		prec  uint
+		write func(x *big.Float) big.Accuracy
+		read  func() *big.Float
+	}{
+		{64, LE(tmp).WriteFloat80Big, LE(tmp).ReadFloat80Big},
+		{64, BE(tmp).WriteFloat80Big, BE(tmp).ReadFloat80Big},
+		{113, LE(tmp).WriteFloat128Big, LE(tmp).ReadFloat128Big},
+		{113, BE(tmp).WriteFloat128Big, BE(tmp).ReadFloat128Big},
+	}
+
+	for _, tt := range tests {
+		ulp := pow2(1, 1-int(tt.prec))
+		minSub := pow2(1, -16382-int(tt.prec)+1)
+		cases := []struct {
+			x, expected *big.Float
+			acc         big.Accuracy
+		}{
+			{sum(one, pow2(0.5, 1-int(tt.prec))), one, big.Below},
+			{sum(one, pow2(1.5, 1-int(tt.prec))), sum(one, pow2(2, 1-int(tt.prec))), big.Above},
+			{sum(big.NewFloat(2), pow2(-0.25, 1-int(tt.prec))), big.NewFloat(2), big.Above},
+			{sum(one, ulp), sum(one, ulp), big.Exact},
+			{pow2(0.5, -16382-int(tt.prec)+1), new(big.Float), big.Below},
+			{pow2(0.75, -16382-int(tt.prec)+1), minSub, big.Above},
+			{pow2(-0.25, -16382-int(tt.prec)+1), new(big.Float).Neg(new(big.Float)), big.Above},
+			{sum(minNormal, new(big.Float).Neg(pow2(0.5, -16382-int(tt.prec)+1))), minNormal, big.Above},
+			{sum(minNormal, new(big.Float).Neg(minSub)), sum(minNormal, new(big.Float).Neg(minSub)), big.Exact},
+			{pow2(1, 16384), new(big.Float).SetInf(false), big.Above},
+			{pow2(-1, 16384), new(big.Float).SetInf(true), big.Below},
+		}
+
+		for _, c := range cases {
+			if acc := tt.write(c.x); acc != c.acc {
+				t.Fatalf("%d: expected %v for %v but got %v", tt.prec, c.acc, c.x, acc)
+			}
+
+			if r := tt.read(); r.Cmp(c.expected) != 0 || r.Signbit() != c.expected.Signbit() {
+				t.Fatalf("%d: expected %v but got %v", tt.prec, c.expected, r)
+			}
+		}
+	}
+}
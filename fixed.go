@@ -0,0 +1,199 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// QFormat describes a signed two's complement fixed-point format in the ARM notation Qm.n, where IntBits (m)
+// includes the sign bit and FracBits (n) is the amount of fractional bits. The encoded width is m+n bits and must
+// be one of 8, 16, 24, 32, 40, 48, 56 or 64, e.g. Q15 (Q1.15) occupies 16 bit and Q16.16 occupies 32 bit. Note
+// that the TI notation excludes the sign bit from m, so that TI Q0.15 equals ARM Q1.15.
+type QFormat struct {
+	// IntBits is the amount of integer bits including the sign bit and must be at least 1.
+	IntBits int
+
+	// FracBits is the amount of fractional bits.
+	FracBits int
+}
+
+//nolint:gochecknoglobals
+var (
+	// Q7 is the Q1.7 format with a width of 8 bit.
+	Q7 = QFormat{IntBits: 1, FracBits: 7}
+	// Q15 is the Q1.15 format with a width of 16 bit.
+	Q15 = QFormat{IntBits: 1, FracBits: 15}
+	// Q23 is the Q1.23 format with a width of 24 bit, e.g. used for audio samples.
+	Q23 = QFormat{IntBits: 1, FracBits: 23}
+	// Q31 is the Q1.31 format with a width of 32 bit.
+	Q31 = QFormat{IntBits: 1, FracBits: 31}
+)
+
+// String returns the ARM notation like Q1.15, where the integer bits include the sign bit.
+func (q QFormat) String() string {
+	return "Q" + strconv.Itoa(q.IntBits) + "." + strconv.Itoa(q.FracBits)
+}
+
+// Width returns the encoded size in bytes. Panics if the format is invalid.
+func (q QFormat) Width() int {
+	bits := q.IntBits + q.FracBits
+	if q.IntBits < 1 || q.FracBits < 0 || bits > 64 || bits%8 != 0 {
+		panic("byteorder: invalid fixed-point format " + q.String())
+	}
+
+	return bits / 8 //nolint:gomnd
+}
+
+// Min returns the smallest representable value. Panics if the format is invalid.
+func (q QFormat) Min() Fixed {
+	return Fixed{Raw: minIntOf(q.Width()), Format: q}
+}
+
+// Max returns the largest representable value. Panics if the format is invalid.
+func (q QFormat) Max() Fixed {
+	return Fixed{Raw: maxIntOf(q.Width()), Format: q}
+}
+
+// RoundingMode determines how a float is rounded to the nearest representable fixed-point value.
+type RoundingMode uint8
+
+const (
+	// RoundNearestEven rounds to the nearest value and ties to the value with an even least significant bit.
+	RoundNearestEven RoundingMode = iota
+	// RoundNearestAway rounds to the nearest value and ties away from zero.
+	RoundNearestAway
+	// RoundTowardZero truncates towards zero.
+	RoundTowardZero
+	// RoundDown rounds towards negative infinity, which equals the truncation of two's complement bits.
+	RoundDown
+	// RoundUp rounds towards positive infinity.
+	RoundUp
+)
+
+// round applies the rounding mode to f.
+func (m RoundingMode) round(f float64) float64 {
+	switch m {
+	case RoundNearestAway:
+		return math.Round(f)
+	case RoundTowardZero:
+		return math.Trunc(f)
+	case RoundDown:
+		return math.Floor(f)
+	case RoundUp:
+		return math.Ceil(f)
+	default:
+		return math.RoundToEven(f)
+	}
+}
+
+// FromFloat64 converts f into the nearest fixed-point value according to the rounding mode. Values outside of the
+// representable range saturate to Min or Max and NaN becomes zero, in which cases ok is false. Panics if the
+// format is invalid.
+func (q QFormat) FromFloat64(f float64, mode RoundingMode) (v Fixed, ok bool) {
+	bits := 8 * q.Width() //nolint:gomnd
+	limit := math.Ldexp(1, bits-1)
+	r := mode.round(math.Ldexp(f, q.FracBits))
+
+	switch {
+	case math.IsNaN(r):
+		return Fixed{Format: q}, false
+	case r >= limit:
+		return q.Max(), false
+	case r < -limit:
+		return q.Min(), false
+	default:
+		return Fixed{Raw: int64(r), Format: q}, true
+	}
+}
+
+// Fixed is a signed fixed-point value, which represents Raw * 2^-Format.FracBits.
+type Fixed struct {
+	// Raw is the two's complement integer representation.
+	Raw int64
+
+	// Format describes the position of the binary point.
+	Format QFormat
+}
+
+// Float64 returns the nearest float64 of the value, which is exact for widths up to 53 bit.
+func (v Fixed) Float64() float64 {
+	return math.Ldexp(float64(v.Raw), -v.Format.FracBits)
+}
+
+// String returns the exact decimal representation without trailing zeros.
+func (v Fixed) String() string {
+	x := new(big.Float).SetInt64(v.Raw)
+	s := x.SetMantExp(x, -v.Format.FracBits).Text('f', v.Format.FracBits)
+
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
+
+// ReadFixed reads the first Width() bytes as a value of the format q. Panics when len(b) < q.Width() or if q is
+// invalid.
+func (b LittleEndian) ReadFixed(q QFormat) Fixed {
+	return Fixed{Raw: b.ReadInt(q.Width()), Format: q}
+}
+
+// WriteFixed writes the first v.Format.Width() bytes. Panics when len(b) is too small, if the format is invalid
+// or if v.Raw does not fit into the format.
+func (b LittleEndian) WriteFixed(v Fixed) {
+	b.WriteInt(v.Format.Width(), v.Raw)
+}
+
+// ReadFixed reads the first Width() bytes as a value of the format q. Panics when len(b) < q.Width() or if q is
+// invalid.
+func (b BigEndian) ReadFixed(q QFormat) Fixed {
+	return Fixed{Raw: b.ReadInt(q.Width()), Format: q}
+}
+
+// WriteFixed writes the first v.Format.Width() bytes. Panics when len(b) is too small, if the format is invalid
+// or if v.Raw does not fit into the format.
+func (b BigEndian) WriteFixed(v Fixed) {
+	b.WriteInt(v.Format.Width(), v.Raw)
+}
+
+// ReadFixed reads the first Width() bytes of b as a value of the format q. Panics when len(b) < q.Width() or if q
+// is invalid.
+func (o Order) ReadFixed(b []byte, q QFormat) Fixed {
+	return Fixed{Raw: o.ReadInt(b, q.Width()), Format: q}
+}
+
+// WriteFixed writes the first v.Format.Width() bytes of b. Panics when len(b) is too small, if the format is
+// invalid or if v.Raw does not fit into the format.
+func (o Order) WriteFixed(b []byte, v Fixed) {
+	o.WriteInt(b, v.Format.Width(), v.Raw)
+}
+
+// ReadFixed reads the next Width() bytes as a value of the format q and advances the position. Panics when
+// Remaining() < q.Width() or if q is invalid.
+func (r *Reader) ReadFixed(q QFormat) Fixed {
+	return Fixed{Raw: r.ReadInt(q.Width()), Format: q}
+}
+
+// AppendFixed appends v.Format.Width() bytes. Panics if the format is invalid or if v.Raw does not fit into the
+// format.
+func (b *Buffer) AppendFixed(v Fixed) {
+	b.AppendInt(v.Format.Width(), v.Raw)
+}
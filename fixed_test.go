@@ -0,0 +1,128 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestQFormat(t *testing.T) {
+	q16 := QFormat{IntBits: 16, FracBits: 16}
+
+	tests := []struct {
+		q     QFormat
+		f     float64
+		raw   int64
+		ok    bool
+		width int
+	}{
+		{Q7, -1, -128, true, 1},
+		{Q15, 0.5, 1 << 14, true, 2},
+		{Q15, 1, int64(MaxInt16), false, 2},
+		{Q15, -1, int64(MinInt16), true, 2},
+		{Q15, -2, int64(MinInt16), false, 2},
+		{Q15, math.NaN(), 0, false, 2},
+		{Q23, -0.25, -1 << 21, true, 3},
+		{Q31, math.Inf(1), int64(MaxInt32), false, 4},
+		{q16, 1.5, 0x18000, true, 4},
+		{q16, -32768, int64(MinInt32), true, 4},
+		{QFormat{IntBits: 8, FracBits: 32}, 1.0 / 3, 0x55555555, true, 5},
+		{QFormat{IntBits: 64}, -1 << 63, int64(MinInt64), true, 8},
+		{QFormat{IntBits: 64}, 1 << 63, int64(MaxInt64), false, 8},
+	}
+
+	for _, tt := range tests {
+		v, ok := tt.q.FromFloat64(tt.f, RoundNearestEven)
+		if v.Raw != tt.raw || ok != tt.ok || v.Format != tt.q || tt.q.Width() != tt.width {
+			t.Fatalf("%v %v: expected %x %v but got %x %v", tt.q, tt.f, tt.raw, tt.ok, v.Raw, ok)
+		}
+
+		if ok && v.Float64() != math.Ldexp(float64(tt.raw), -tt.q.FracBits) {
+			t.Fatalf("%v %v: unexpected float %v", tt.q, tt.f, v.Float64())
+		}
+	}
+
+	if Q15.String() != "Q1.15" || q16.Max().String() != "32767.9999847412109375" || Q15.Min().String() != "-1" {
+		t.Fatalf("unexpected strings %v %v %v", Q15, q16.Max(), Q15.Min())
+	}
+}
+
+func TestRoundingMode(t *testing.T) {
+	q := QFormat{IntBits: 7, FracBits: 1}
+
+	tests := map[RoundingMode][]int64{
+		RoundNearestEven: {2, -2, 3, -1},
+		RoundNearestAway: {3, -3, 3, -1},
+		RoundTowardZero:  {2, -2, 2, 0},
+		RoundDown:        {2, -3, 2, -1},
+		RoundUp:          {3, -2, 3, 0},
+	}
+
+	for mode, expected := range tests {
+		for i, f := range []float64{1.25, -1.25, 1.4, -0.3} {
+			if v, ok := q.FromFloat64(f, mode); !ok || v.Raw != expected[i] {
+				t.Fatalf("%d %v: expected %d but got %d", mode, f, expected[i], v.Raw)
+			}
+		}
+	}
+}
+
+func TestFixedByteOrder(t *testing.T) {
+	sample, _ := Q23.FromFloat64(0.5, RoundNearestEven)
+
+	tmp := make([]byte, 3)
+	LE(tmp).WriteFixed(sample)
+
+	if tmp[0] != 0 || tmp[1] != 0 || tmp[2] != 0x40 || LE(tmp).ReadFixed(Q23) != sample {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	BE(tmp).WriteFixed(Q23.Min())
+
+	if tmp[0] != 0x80 || BE(tmp).ReadFixed(Q23) != Q23.Min() {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	for _, order := range []Order{Little, Big} {
+		buf := NewBuffer(nil, order)
+		buf.AppendFixed(sample)
+		buf.AppendFixed(Q31.Max())
+
+		r := NewReader(buf.Bytes(), order)
+		if r.ReadFixed(Q23) != sample || order.ReadFixed(buf.Bytes()[3:], Q31) != Q31.Max() {
+			t.Fatalf("%v: unexpected round trip of %x", order, buf.Bytes())
+		}
+
+		order.WriteFixed(tmp, sample)
+
+		if order.View(tmp).ReadUint24() != 0x400000 {
+			t.Fatalf("%v: unexpected encoding %x", order, tmp)
+		}
+	}
+
+	assertOverflow(t, func() { LE(tmp).WriteFixed(Fixed{Raw: 1 << 23, Format: Q23}) })
+}
+
+func TestQFormatInvalid(t *testing.T) {
+	for _, q := range []QFormat{{}, {IntBits: 1, FracBits: -1}, {IntBits: 1, FracBits: 14}, {IntBits: 1, FracBits: 71}} {
+		q := q
+		assertWidthPanics(t, q.IntBits+q.FracBits, func() { q.Width() })
+	}
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import "math"
+
+// Float16frombits returns the float32 corresponding to the IEEE 754 binary16 (half precision) representation h.
+// The conversion is exact, including subnormals, infinities and NaN payloads.
+func Float16frombits(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f: // infinity or NaN
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0: // zero or subnormal, whose value mant * 2^-24 is always normal as float32
+		return math.Float32frombits(sign | math.Float32bits(float32(mant)/(1<<24)))
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// Float16bits returns the IEEE 754 binary16 (half precision) representation of f. The value is rounded to the
+// nearest representable value, ties to even. Values beyond the range overflow to infinity and tiny values
+// become subnormal or zero. A NaN stays a quiet NaN and keeps the upper bits of its payload.
+func Float16bits(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant == 0 {
+			return sign | 0x7c00
+		}
+
+		return sign | 0x7e00 | uint16(mant>>13)
+	}
+
+	e := exp - 127
+
+	switch {
+	case e > 15:
+		return sign | 0x7c00
+	case e >= -14:
+		// a carry of the rounding into the exponent is correct and results in infinity at worst
+		return sign | roundToEven(uint32(e+15)<<23|mant, 13) //nolint:gomnd
+	case e >= -25:
+		// subnormal, so add the implicit leading bit of the normal float32 and shift into the 2^-24 unit
+		return sign | roundToEven(mant|0x800000, uint(-e-1))
+	default:
+		return sign
+	}
+}
+
+// roundToEven shifts v to the right and rounds the discarded bits to the nearest value, ties to even.
+func roundToEven(v uint32, shift uint) uint16 {
+	r := v >> shift
+	rem := v & (1<<shift - 1)
+	half := uint32(1) << (shift - 1)
+
+	if rem > half || (rem == half && r&1 == 1) {
+		r++
+	}
+
+	return uint16(r)
+}
+
+// BFloat16frombits returns the float32 corresponding to the bfloat16 representation h. The conversion is exact,
+// because bfloat16 is just a float32 with a truncated mantissa.
+func BFloat16frombits(h uint16) float32 {
+	return math.Float32frombits(uint32(h) << 16)
+}
+
+// BFloat16bits returns the bfloat16 representation of f. The value is rounded to the nearest representable value,
+// ties to even. A NaN stays a quiet NaN and keeps the upper bits of its payload.
+func BFloat16bits(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		return uint16(bits>>16) | 0x40
+	}
+
+	return uint16((bits + 0x7fff + (bits>>16)&1) >> 16)
+}
+
+// ReadFloat16 reads 2 bytes and interprets them as a IEEE 754 binary16 bit sequence. Panics when len(b) < 2.
+func (b LittleEndian) ReadFloat16() float32 {
+	return Float16frombits(b.ReadUint16())
+}
+
+// WriteFloat16 writes v as a IEEE 754 binary16 bit sequence, see also Float16bits. Panics when len(b) < 2.
+func (b LittleEndian) WriteFloat16(v float32) {
+	b.WriteUint16(Float16bits(v))
+}
+
+// ReadBFloat16 reads 2 bytes and interprets them as a bfloat16 bit sequence. Panics when len(b) < 2.
+func (b LittleEndian) ReadBFloat16() float32 {
+	return BFloat16frombits(b.ReadUint16())
+}
+
+// WriteBFloat16 writes v as a bfloat16 bit sequence, see also BFloat16bits. Panics when len(b) < 2.
+func (b LittleEndian) WriteBFloat16(v float32) {
+	b.WriteUint16(BFloat16bits(v))
+}
+
+// ReadFloat16 reads 2 bytes and interprets them as a IEEE 754 binary16 bit sequence. Panics when len(b) < 2.
+func (b BigEndian) ReadFloat16() float32 {
+	return Float16frombits(b.ReadUint16())
+}
+
+// WriteFloat16 writes v as a IEEE 754 binary16 bit sequence, see also Float16bits. Panics when len(b) < 2.
+func (b BigEndian) WriteFloat16(v float32) {
+	b.WriteUint16(Float16bits(v))
+}
+
+// ReadBFloat16 reads 2 bytes and interprets them as a bfloat16 bit sequence. Panics when len(b) < 2.
+func (b BigEndian) ReadBFloat16() float32 {
+	return BFloat16frombits(b.ReadUint16())
+}
+
+// WriteBFloat16 writes v as a bfloat16 bit sequence, see also BFloat16bits. Panics when len(b) < 2.
+func (b BigEndian) WriteBFloat16(v float32) {
+	b.WriteUint16(BFloat16bits(v))
+}
+
+// ReadFloat16s decodes len(dst) IEEE 754 binary16 values of 2 bytes each from src. Panics when len(src) < 2*len(dst).
+func (o Order) ReadFloat16s(dst []float32, src []byte) {
+	mustFit(2*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = Float16frombits(o.ReadUint16(src[2*i:]))
+	}
+}
+
+// WriteFloat16s encodes all values from src as IEEE 754 binary16 into dst using 2 bytes each. Panics when
+// len(dst) < 2*len(src).
+func (o Order) WriteFloat16s(dst []byte, src []float32) {
+	mustFit(2*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteUint16(dst[2*i:], Float16bits(v))
+	}
+}
+
+// ReadBFloat16s decodes len(dst) bfloat16 values of 2 bytes each from src. Panics when len(src) < 2*len(dst).
+func (o Order) ReadBFloat16s(dst []float32, src []byte) {
+	mustFit(2*len(dst), len(src))
+
+	for i := range dst {
+		dst[i] = BFloat16frombits(o.ReadUint16(src[2*i:]))
+	}
+}
+
+// WriteBFloat16s encodes all values from src as bfloat16 into dst using 2 bytes each. Panics when
+// len(dst) < 2*len(src).
+func (o Order) WriteBFloat16s(dst []byte, src []float32) {
+	mustFit(2*len(src), len(dst))
+
+	for i, v := range src {
+		o.WriteUint16(dst[2*i:], BFloat16bits(v))
+	}
+}
+
+// ReadFloat16s decodes len(dst) IEEE 754 binary16 values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b LittleEndian) ReadFloat16s(dst []float32) {
+	Little.ReadFloat16s(dst, b)
+}
+
+// WriteFloat16s encodes all values from src as IEEE 754 binary16 using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b LittleEndian) WriteFloat16s(src []float32) {
+	Little.WriteFloat16s(b, src)
+}
+
+// ReadBFloat16s decodes len(dst) bfloat16 values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b LittleEndian) ReadBFloat16s(dst []float32) {
+	Little.ReadBFloat16s(dst, b)
+}
+
+// WriteBFloat16s encodes all values from src as bfloat16 using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b LittleEndian) WriteBFloat16s(src []float32) {
+	Little.WriteBFloat16s(b, src)
+}
+
+// ReadFloat16s decodes len(dst) IEEE 754 binary16 values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b BigEndian) ReadFloat16s(dst []float32) {
+	Big.ReadFloat16s(dst, b)
+}
+
+// WriteFloat16s encodes all values from src as IEEE 754 binary16 using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b BigEndian) WriteFloat16s(src []float32) {
+	Big.WriteFloat16s(b, src)
+}
+
+// ReadBFloat16s decodes len(dst) bfloat16 values of 2 bytes each. Panics when len(b) < 2*len(dst).
+func (b BigEndian) ReadBFloat16s(dst []float32) {
+	Big.ReadBFloat16s(dst, b)
+}
+
+// WriteBFloat16s encodes all values from src as bfloat16 using 2 bytes each. Panics when len(b) < 2*len(src).
+func (b BigEndian) WriteBFloat16s(src []float32) {
+	Big.WriteBFloat16s(b, src)
+}
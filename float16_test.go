@@ -0,0 +1,162 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestFloat16RoundTrip(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := uint16(i)
+		f := Float16frombits(h)
+
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			if !math.IsNaN(float64(f)) || Float16bits(f) != h|0x200 {
+				t.Fatalf("%04x: NaN payload is not preserved", h)
+			}
+
+			continue
+		}
+
+		if r := Float16bits(f); r != h {
+			t.Fatalf("%04x: round trip of %v results in %04x", h, f, r)
+		}
+	}
+}
+
+func TestFloat16bits(t *testing.T) {
+	tests := []struct {
+		f float32
+		h uint16
+	}{
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{float32(math.Copysign(0, -1)), 0x8000},
+		{65504, 0x7bff},
+		{65519, 0x7bff},
+		{65520, 0x7c00},
+		{1e10, 0x7c00},
+		{float32(math.Inf(-1)), 0xfc00},
+		{1.0 / (1 << 14), 0x0400},
+		{1.0 / (1 << 24), 0x0001},
+		{1.0 / (1 << 25), 0x0000},
+		{1.5 / (1 << 25), 0x0001},
+		{3.0 / (1 << 25), 0x0002},
+		{(1<<10 - 0.5) / (1 << 24), 0x0400},
+		{1 + 1.0/(1<<11), 0x3c00},
+		{1 + 3.0/(1<<11), 0x3c02},
+		{1e-10, 0x0000},
+		{math.Float32frombits(0x00000001), 0x0000},
+		{math.Float32frombits(0xffc00001), 0xfe00},
+		{math.Float32frombits(0x7f812345), 0x7e09},
+	}
+
+	for _, tt := range tests {
+		if h := Float16bits(tt.f); h != tt.h {
+			t.Fatalf("%v: expected %04x but got %04x", tt.f, tt.h, h)
+		}
+	}
+}
+
+func TestBFloat16(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := uint16(i)
+		if f := BFloat16frombits(h); !math.IsNaN(float64(f)) && BFloat16bits(f) != h {
+			t.Fatalf("%04x: round trip of %v results in %04x", h, f, BFloat16bits(f))
+		}
+	}
+
+	tests := map[uint32]uint16{
+		0x3f808000: 0x3f80,
+		0x3f818000: 0x3f82,
+		0x3f808001: 0x3f81,
+		0x7f7fffff: 0x7f80,
+		0x00018000: 0x0002,
+		0x7f800001: 0x7fc0,
+		0xff812345: 0xffc1,
+	}
+
+	for bits, h := range tests {
+		if r := BFloat16bits(math.Float32frombits(bits)); r != h {
+			t.Fatalf("%08x: expected %04x but got %04x", bits, h, r)
+		}
+	}
+}
+
+func TestFloat16ByteOrder(t *testing.T) {
+	tmp := make([]byte, 4)
+
+	LE(tmp).WriteFloat16(1)
+	BE(tmp[2:]).WriteBFloat16(1)
+
+	if tmp[0] != 0x00 || tmp[1] != 0x3c || tmp[2] != 0x3f || tmp[3] != 0x80 {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	if LE(tmp).ReadFloat16() != 1 || BE(tmp[2:]).ReadBFloat16() != 1 {
+		t.Fatalf("unexpected decoding %x", tmp)
+	}
+
+	BE(tmp).WriteFloat16(-2)
+	LE(tmp[2:]).WriteBFloat16(-2)
+
+	if BE(tmp).ReadFloat16() != -2 || LE(tmp[2:]).ReadBFloat16() != -2 {
+		t.Fatalf("unexpected decoding %x", tmp)
+	}
+}
+
+func TestFloat16Bulk(t *testing.T) {
+	values := []float32{1, -2, 0.5, 65504}
+	tmp := make([]byte, 2*len(values))
+	res := make([]float32, len(values))
+
+	for _, o := range []ByteOrder{LE(tmp), BE(tmp)} {
+		switch b := o.(type) {
+		case LittleEndian:
+			b.WriteFloat16s(values)
+			b.ReadFloat16s(res)
+			assertFloat32s(t, values, res)
+			b.WriteBFloat16s(values[:3])
+			b.ReadBFloat16s(res[:3])
+			assertFloat32s(t, values[:3], res[:3])
+		case BigEndian:
+			b.WriteFloat16s(values)
+			b.ReadFloat16s(res)
+			assertFloat32s(t, values, res)
+			b.WriteBFloat16s(values[:3])
+			b.ReadBFloat16s(res[:3])
+			assertFloat32s(t, values[:3], res[:3])
+		}
+	}
+
+	assertBulkShort(t, func() { LE(tmp).ReadFloat16s(make([]float32, 5)) })
+	assertBulkShort(t, func() { BE(tmp).WriteBFloat16s(make([]float32, 5)) })
+}
+
+func assertFloat32s(t *testing.T, expected, actual []float32) {
+	t.Helper()
+
+	for i := range expected {
+		if expected[i] != actual[i] {
+			t.Fatalf("expected %v but got %v", expected, actual)
+		}
+	}
+}
@@ -16,6 +16,8 @@
 
 package byteorder
 
+import "strconv"
+
 // UintSize is either 32 or 64.
 const UintSize = 32 << (^uint(0) >> 32 & 1)
 
@@ -78,3 +80,82 @@ const (
 	// MaxUint64 is 18446744073709551615.
 	MaxUint64 uint64 = 1<<64 - 1
 )
+
+// signExtend interprets the lower bits of v as a two's complement value of the given width.
+func signExtend(v uint64, bits uint) int64 {
+	shift := 64 - bits
+
+	return int64(v<<shift) >> shift
+}
+
+// intOverflow returns an OverflowError, if v is not within [min, max].
+func intOverflow(v, min, max int64, bits int) error {
+	if v < min || v > max {
+		return &OverflowError{Value: v, Width: bits}
+	}
+
+	return nil
+}
+
+// checkInt panics with an OverflowError, if v is not within [min, max].
+func checkInt(v, min, max int64, bits int) {
+	if err := intOverflow(v, min, max, bits); err != nil {
+		panic(err)
+	}
+}
+
+// checkUint panics with an OverflowError, if v > max.
+func checkUint(v, max uint64, bits int) {
+	if v > max {
+		panic(&OverflowError{Value: v, Width: bits})
+	}
+}
+
+// mustWidth panics, if the width n in bytes is not within [1, 8].
+func mustWidth(n int) {
+	if n < 1 || n > 8 {
+		panic(invalidWidth(n))
+	}
+}
+
+// invalidWidth returns the panic message for a width n in bytes which is not within [1, 8].
+func invalidWidth(n int) string {
+	return "byteorder: invalid width of " + strconv.Itoa(n) + " bytes"
+}
+
+//nolint:gochecknoglobals
+var (
+	// uintLimits holds MaxUint8 to MaxUint64 indexed by the width in bytes.
+	uintLimits = [...]uint64{
+		1: uint64(MaxUint8), 2: uint64(MaxUint16), 3: uint64(MaxUint24), 4: uint64(MaxUint32),
+		5: MaxUint40, 6: MaxUint48, 7: MaxUint56, 8: MaxUint64,
+	}
+
+	// intLimits holds the pairs of MinInt8 and MaxInt8 to MinInt64 and MaxInt64 indexed by the width in bytes.
+	intLimits = [...][2]int64{
+		1: {int64(MinInt8), int64(MaxInt8)}, 2: {int64(MinInt16), int64(MaxInt16)},
+		3: {int64(MinInt24), int64(MaxInt24)}, 4: {int64(MinInt32), int64(MaxInt32)},
+		5: {MinInt40, MaxInt40}, 6: {MinInt48, MaxInt48}, 7: {MinInt56, MaxInt56}, 8: {MinInt64, MaxInt64},
+	}
+)
+
+// maxUintOf returns the largest unsigned value of n bytes, e.g. MaxUint24 for 3. Panics if n is not within [1, 8].
+func maxUintOf(n int) uint64 {
+	mustWidth(n)
+
+	return uintLimits[n]
+}
+
+// minIntOf returns the smallest signed value of n bytes, e.g. MinInt24 for 3. Panics if n is not within [1, 8].
+func minIntOf(n int) int64 {
+	mustWidth(n)
+
+	return intLimits[n][0]
+}
+
+// maxIntOf returns the largest signed value of n bytes, e.g. MaxInt24 for 3. Panics if n is not within [1, 8].
+func maxIntOf(n int) int64 {
+	mustWidth(n)
+
+	return intLimits[n][1]
+}
@@ -0,0 +1,456 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+//nolint:gochecknoglobals
+var (
+	// MaxUint128 is 340282366920938463463374607431768211455.
+	MaxUint128 = Uint128{Hi: math.MaxUint64, Lo: math.MaxUint64}
+	// MaxInt128 is 170141183460469231731687303715884105727.
+	MaxInt128 = Int128{Hi: math.MaxInt64, Lo: math.MaxUint64}
+	// MinInt128 is -170141183460469231731687303715884105728.
+	MinInt128 = Int128{Hi: math.MinInt64}
+)
+
+// Uint128 is an unsigned 128 bit integer, e.g. for UUIDs, IPv6 addresses or large counters. All arithmetic wraps
+// around on overflow, just like the builtin unsigned integers.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// Add returns u+v.
+func (u Uint128) Add(v Uint128) Uint128 {
+	lo, carry := bits.Add64(u.Lo, v.Lo, 0)
+	hi, _ := bits.Add64(u.Hi, v.Hi, carry)
+
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// Sub returns u-v.
+func (u Uint128) Sub(v Uint128) Uint128 {
+	lo, borrow := bits.Sub64(u.Lo, v.Lo, 0)
+	hi, _ := bits.Sub64(u.Hi, v.Hi, borrow)
+
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// Mul returns u*v.
+func (u Uint128) Mul(v Uint128) Uint128 {
+	hi, lo := bits.Mul64(u.Lo, v.Lo)
+	hi += u.Hi*v.Lo + u.Lo*v.Hi
+
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// QuoRem returns the quotient u/v and the remainder u%v. Panics if v is zero.
+func (u Uint128) QuoRem(v Uint128) (q, r Uint128) {
+	if v.Hi == 0 {
+		q, r.Lo = u.quoRem64(v.Lo)
+
+		return q, r
+	}
+
+	// estimate the quotient from the normalized divisor, which is either correct or one too small, see
+	// Hacker's Delight 9-5
+	n := uint(bits.LeadingZeros64(v.Hi))
+	u1 := u.Rsh(1)
+	tq, _ := bits.Div64(u1.Hi, u1.Lo, v.Lsh(n).Hi)
+	tq >>= 63 - n
+
+	if tq != 0 {
+		tq--
+	}
+
+	q = Uint128{Lo: tq}
+	r = u.Sub(v.Mul(q))
+
+	if r.Cmp(v) >= 0 {
+		q = q.Add(Uint128{Lo: 1})
+		r = r.Sub(v)
+	}
+
+	return q, r
+}
+
+// quoRem64 returns the quotient u/v and the remainder u%v. Panics if v is zero.
+func (u Uint128) quoRem64(v uint64) (q Uint128, r uint64) {
+	q.Hi, r = bits.Div64(0, u.Hi, v)
+	q.Lo, r = bits.Div64(r, u.Lo, v)
+
+	return q, r
+}
+
+// Quo returns u/v. Panics if v is zero.
+func (u Uint128) Quo(v Uint128) Uint128 {
+	q, _ := u.QuoRem(v)
+
+	return q
+}
+
+// Rem returns u%v. Panics if v is zero.
+func (u Uint128) Rem(v Uint128) Uint128 {
+	_, r := u.QuoRem(v)
+
+	return r
+}
+
+// And returns u&v.
+func (u Uint128) And(v Uint128) Uint128 {
+	return Uint128{Hi: u.Hi & v.Hi, Lo: u.Lo & v.Lo}
+}
+
+// Or returns u|v.
+func (u Uint128) Or(v Uint128) Uint128 {
+	return Uint128{Hi: u.Hi | v.Hi, Lo: u.Lo | v.Lo}
+}
+
+// Xor returns u^v.
+func (u Uint128) Xor(v Uint128) Uint128 {
+	return Uint128{Hi: u.Hi ^ v.Hi, Lo: u.Lo ^ v.Lo}
+}
+
+// Lsh returns u<<n. Just like for the builtin integers, the result is zero if n >= 128.
+func (u Uint128) Lsh(n uint) Uint128 {
+	if n >= 64 { //nolint:gomnd
+		return Uint128{Hi: u.Lo << (n - 64)}
+	}
+
+	return Uint128{Hi: u.Hi<<n | u.Lo>>(64-n), Lo: u.Lo << n}
+}
+
+// Rsh returns u>>n. Just like for the builtin integers, the result is zero if n >= 128.
+func (u Uint128) Rsh(n uint) Uint128 {
+	if n >= 64 { //nolint:gomnd
+		return Uint128{Lo: u.Hi >> (n - 64)}
+	}
+
+	return Uint128{Hi: u.Hi >> n, Lo: u.Lo>>n | u.Hi<<(64-n)}
+}
+
+// Cmp returns -1 if u < v, 0 if u == v and +1 if u > v.
+func (u Uint128) Cmp(v Uint128) int {
+	switch {
+	case u == v:
+		return 0
+	case u.Hi < v.Hi || (u.Hi == v.Hi && u.Lo < v.Lo):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Big returns u as a big.Int.
+func (u Uint128) Big() *big.Int {
+	b := new(big.Int).SetUint64(u.Hi)
+
+	return b.Lsh(b, 64).Or(b, new(big.Int).SetUint64(u.Lo))
+}
+
+// String returns the decimal representation of u.
+func (u Uint128) String() string {
+	const chunk = 1e19 // the largest power of 10 which fits into an uint64
+
+	if u.Hi == 0 {
+		return strconv.FormatUint(u.Lo, 10)
+	}
+
+	q, r := u.quoRem64(chunk)
+	digits := strconv.FormatUint(r, 10)
+
+	return q.String() + "0000000000000000000"[len(digits):] + digits
+}
+
+// ParseUint128 interprets s as a decimal unsigned 128 bit integer. Errors are of type *strconv.NumError.
+func ParseUint128(s string) (Uint128, error) {
+	b, ok := new(big.Int).SetString(s, 10)
+
+	switch {
+	case !ok || (len(s) > 0 && (s[0] == '+' || s[0] == '-')):
+		return Uint128{}, &strconv.NumError{Func: "ParseUint128", Num: s, Err: strconv.ErrSyntax}
+	case b.BitLen() > 128:
+		return MaxUint128, &strconv.NumError{Func: "ParseUint128", Num: s, Err: strconv.ErrRange}
+	}
+
+	return uint128Of(b), nil
+}
+
+// uint128Of returns the lower 128 bit of the absolute value of b.
+func uint128Of(b *big.Int) Uint128 {
+	b = new(big.Int).Abs(b)
+	lo := new(big.Int).And(b, new(big.Int).SetUint64(math.MaxUint64))
+	hi := new(big.Int).Rsh(b, 64)
+
+	return Uint128{Hi: hi.Uint64(), Lo: lo.Uint64()}
+}
+
+// Int128 is a signed 128 bit integer in two's complement. All arithmetic wraps around on overflow, just like the
+// builtin signed integers.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// bits returns the two's complement bit pattern of i.
+func (i Int128) bits() Uint128 {
+	return Uint128{Hi: uint64(i.Hi), Lo: i.Lo}
+}
+
+// int128Of interprets the bit pattern u as two's complement.
+func int128Of(u Uint128) Int128 {
+	return Int128{Hi: int64(u.Hi), Lo: u.Lo}
+}
+
+// Sign returns -1 if i < 0, 0 if i == 0 and +1 if i > 0.
+func (i Int128) Sign() int {
+	switch {
+	case i.Hi < 0:
+		return -1
+	case i.Hi == 0 && i.Lo == 0:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Neg returns -i. The negation of MinInt128 is MinInt128.
+func (i Int128) Neg() Int128 {
+	return int128Of(Uint128{}.Sub(i.bits()))
+}
+
+// abs returns the absolute value of i as unsigned integer, which is correct even for MinInt128.
+func (i Int128) abs() Uint128 {
+	if i.Hi < 0 {
+		return i.Neg().bits()
+	}
+
+	return i.bits()
+}
+
+// Add returns i+j.
+func (i Int128) Add(j Int128) Int128 {
+	return int128Of(i.bits().Add(j.bits()))
+}
+
+// Sub returns i-j.
+func (i Int128) Sub(j Int128) Int128 {
+	return int128Of(i.bits().Sub(j.bits()))
+}
+
+// Mul returns i*j.
+func (i Int128) Mul(j Int128) Int128 {
+	return int128Of(i.bits().Mul(j.bits()))
+}
+
+// QuoRem returns the quotient i/j truncated towards zero and the remainder i%j, which has the sign of i.
+// Panics if j is zero.
+func (i Int128) QuoRem(j Int128) (q, r Int128) {
+	uq, ur := i.abs().QuoRem(j.abs())
+	q, r = int128Of(uq), int128Of(ur)
+
+	if (i.Hi < 0) != (j.Hi < 0) {
+		q = q.Neg()
+	}
+
+	if i.Hi < 0 {
+		r = r.Neg()
+	}
+
+	return q, r
+}
+
+// Quo returns i/j truncated towards zero. Panics if j is zero.
+func (i Int128) Quo(j Int128) Int128 {
+	q, _ := i.QuoRem(j)
+
+	return q
+}
+
+// Rem returns i%j, which has the sign of i. Panics if j is zero.
+func (i Int128) Rem(j Int128) Int128 {
+	_, r := i.QuoRem(j)
+
+	return r
+}
+
+// Cmp returns -1 if i < j, 0 if i == j and +1 if i > j.
+func (i Int128) Cmp(j Int128) int {
+	switch {
+	case i == j:
+		return 0
+	case i.Hi < j.Hi || (i.Hi == j.Hi && i.Lo < j.Lo):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Big returns i as a big.Int.
+func (i Int128) Big() *big.Int {
+	b := i.abs().Big()
+	if i.Hi < 0 {
+		b.Neg(b)
+	}
+
+	return b
+}
+
+// String returns the decimal representation of i.
+func (i Int128) String() string {
+	if i.Hi < 0 {
+		return "-" + i.abs().String()
+	}
+
+	return i.bits().String()
+}
+
+// ParseInt128 interprets s as a decimal signed 128 bit integer. Errors are of type *strconv.NumError.
+func ParseInt128(s string) (Int128, error) {
+	b, ok := new(big.Int).SetString(s, 10)
+
+	switch {
+	case !ok:
+		return Int128{}, &strconv.NumError{Func: "ParseInt128", Num: s, Err: strconv.ErrSyntax}
+	case b.Cmp(MaxInt128.Big()) > 0:
+		return MaxInt128, &strconv.NumError{Func: "ParseInt128", Num: s, Err: strconv.ErrRange}
+	case b.Cmp(MinInt128.Big()) < 0:
+		return MinInt128, &strconv.NumError{Func: "ParseInt128", Num: s, Err: strconv.ErrRange}
+	}
+
+	i := int128Of(uint128Of(b))
+	if b.Sign() < 0 {
+		i = i.Neg()
+	}
+
+	return i, nil
+}
+
+// ReadUint128 reads the first 16 bytes. Panics when len(b) < 16.
+func (b LittleEndian) ReadUint128() Uint128 {
+	return Uint128{Hi: LittleEndian(b[8:]).ReadUint64(), Lo: b.ReadUint64()}
+}
+
+// WriteUint128 writes the first 16 bytes. Panics when len(b) < 16.
+func (b LittleEndian) WriteUint128(v Uint128) {
+	_ = b[15] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(v.Lo)
+	LittleEndian(b[8:]).WriteUint64(v.Hi)
+}
+
+// ReadInt128 reads the first 16 bytes as a signed two's complement integer. Panics when len(b) < 16.
+func (b LittleEndian) ReadInt128() Int128 {
+	return int128Of(b.ReadUint128())
+}
+
+// WriteInt128 writes the first 16 bytes. Panics when len(b) < 16.
+func (b LittleEndian) WriteInt128(v Int128) {
+	b.WriteUint128(v.bits())
+}
+
+// ReadUint128 reads the first 16 bytes. Panics when len(b) < 16.
+func (b BigEndian) ReadUint128() Uint128 {
+	return Uint128{Hi: b.ReadUint64(), Lo: BigEndian(b[8:]).ReadUint64()}
+}
+
+// WriteUint128 writes the first 16 bytes. Panics when len(b) < 16.
+func (b BigEndian) WriteUint128(v Uint128) {
+	_ = b[15] // early bounds check to guarantee safety of writes below
+	b.WriteUint64(v.Hi)
+	BigEndian(b[8:]).WriteUint64(v.Lo)
+}
+
+// ReadInt128 reads the first 16 bytes as a signed two's complement integer. Panics when len(b) < 16.
+func (b BigEndian) ReadInt128() Int128 {
+	return int128Of(b.ReadUint128())
+}
+
+// WriteInt128 writes the first 16 bytes. Panics when len(b) < 16.
+func (b BigEndian) WriteInt128(v Int128) {
+	b.WriteUint128(v.bits())
+}
+
+// ReadUint128 reads the first 16 bytes of b. Panics when len(b) < 16.
+func (o Order) ReadUint128(b []byte) Uint128 {
+	if o == Big {
+		return BigEndian(b).ReadUint128()
+	}
+
+	return LittleEndian(b).ReadUint128()
+}
+
+// WriteUint128 writes the first 16 bytes of b. Panics when len(b) < 16.
+func (o Order) WriteUint128(b []byte, v Uint128) {
+	if o == Big {
+		BigEndian(b).WriteUint128(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint128(v)
+}
+
+// ReadUint128 reads the next 16 bytes and advances the position. Panics when Remaining() < 16.
+func (r *Reader) ReadUint128() Uint128 {
+	return r.order.ReadUint128(r.Next(16)) //nolint:gomnd
+}
+
+// AppendUint128 appends 16 bytes.
+func (b *Buffer) AppendUint128(v Uint128) {
+	var tmp [16]byte
+
+	b.order.WriteUint128(tmp[:], v)
+	b.buf = append(b.buf, tmp[:]...)
+}
+
+// ReadInt128 reads the first 16 bytes of b. Panics when len(b) < 16.
+func (o Order) ReadInt128(b []byte) Int128 {
+	if o == Big {
+		return BigEndian(b).ReadInt128()
+	}
+
+	return LittleEndian(b).ReadInt128()
+}
+
+// WriteInt128 writes the first 16 bytes of b. Panics when len(b) < 16.
+func (o Order) WriteInt128(b []byte, v Int128) {
+	if o == Big {
+		BigEndian(b).WriteInt128(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt128(v)
+}
+
+// ReadInt128 reads the next 16 bytes and advances the position. Panics when Remaining() < 16.
+func (r *Reader) ReadInt128() Int128 {
+	return r.order.ReadInt128(r.Next(16)) //nolint:gomnd
+}
+
+// AppendInt128 appends 16 bytes.
+func (b *Buffer) AppendInt128(v Int128) {
+	var tmp [16]byte
+
+	b.order.WriteInt128(tmp[:], v)
+	b.buf = append(b.buf, tmp[:]...)
+}
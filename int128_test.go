@@ -0,0 +1,216 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func uint128Values() []Uint128 {
+	values := []Uint128{{}, {Lo: 1}, {Lo: 10}, {Hi: 1}, {Hi: 1, Lo: 1}, {Hi: 1 << 63}, MaxUint128, {Lo: MaxUint64}}
+	rnd := rand.New(rand.NewSource(42)) //nolint:gosec
+
+	for i := 0; i < 50; i++ {
+		values = append(values, Uint128{Hi: rnd.Uint64() >> uint(rnd.Intn(64)), Lo: rnd.Uint64()})
+		values = append(values, Uint128{Lo: rnd.Uint64() >> uint(rnd.Intn(64))})
+	}
+
+	return values
+}
+
+func TestUint128Arithmetic(t *testing.T) {
+	mod := new(big.Int).Lsh(big.NewInt(1), 128)
+	wrap := func(b *big.Int) string { return new(big.Int).Mod(b, mod).String() }
+
+	for _, u := range uint128Values() {
+		if u.String() != u.Big().String() {
+			t.Fatalf("unexpected string %s", u)
+		}
+
+		for _, v := range uint128Values() {
+			a, b := u.Big(), v.Big()
+
+			if u.Add(v).String() != wrap(new(big.Int).Add(a, b)) ||
+				u.Sub(v).String() != wrap(new(big.Int).Sub(a, b)) ||
+				u.Mul(v).String() != wrap(new(big.Int).Mul(a, b)) ||
+				u.And(v).String() != new(big.Int).And(a, b).String() ||
+				u.Or(v).String() != new(big.Int).Or(a, b).String() ||
+				u.Xor(v).String() != new(big.Int).Xor(a, b).String() ||
+				u.Cmp(v) != a.Cmp(b) {
+				t.Fatalf("unexpected arithmetic for %s and %s", u, v)
+			}
+
+			if b.Sign() != 0 {
+				q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+				if u.Quo(v).String() != q.String() || u.Rem(v).String() != r.String() {
+					t.Fatalf("unexpected division for %s and %s", u, v)
+				}
+			}
+		}
+
+		for _, n := range []uint{0, 1, 63, 64, 65, 127, 128} {
+			if u.Lsh(n).String() != wrap(new(big.Int).Lsh(u.Big(), n)) ||
+				u.Rsh(n).String() != new(big.Int).Rsh(u.Big(), n).String() {
+				t.Fatalf("unexpected shift of %s by %d", u, n)
+			}
+		}
+	}
+}
+
+func TestInt128Arithmetic(t *testing.T) {
+	mod := new(big.Int).Lsh(big.NewInt(1), 128)
+	wrap := func(b *big.Int) string {
+		b = new(big.Int).Mod(b, mod)
+		if b.Cmp(MaxInt128.Big()) > 0 {
+			b.Sub(b, mod)
+		}
+
+		return b.String()
+	}
+
+	var values []Int128
+	for _, u := range uint128Values() {
+		values = append(values, Int128{Hi: int64(u.Hi), Lo: u.Lo})
+	}
+
+	values = append(values, MinInt128, MaxInt128, Int128{Hi: -1, Lo: MaxUint64})
+
+	for _, i := range values {
+		if i.String() != i.Big().String() || i.Sign() != i.Big().Sign() ||
+			i.Neg().String() != wrap(new(big.Int).Neg(i.Big())) {
+			t.Fatalf("unexpected string, sign or negation of %s", i)
+		}
+
+		for _, j := range values {
+			a, b := i.Big(), j.Big()
+
+			if i.Add(j).String() != wrap(new(big.Int).Add(a, b)) ||
+				i.Sub(j).String() != wrap(new(big.Int).Sub(a, b)) ||
+				i.Mul(j).String() != wrap(new(big.Int).Mul(a, b)) ||
+				i.Cmp(j) != a.Cmp(b) {
+				t.Fatalf("unexpected arithmetic for %s and %s", i, j)
+			}
+
+			if b.Sign() != 0 {
+				q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+				if i.Quo(j).String() != wrap(q) || i.Rem(j).String() != r.String() {
+					t.Fatalf("unexpected division for %s and %s", i, j)
+				}
+			}
+		}
+	}
+}
+
+func TestParseUint128(t *testing.T) {
+	for _, u := range uint128Values() {
+		if v, err := ParseUint128(u.String()); err != nil || v != u {
+			t.Fatalf("unexpected parse result %s: %v", v, err)
+		}
+	}
+
+	for s, expected := range map[string]error{
+		"":     strconv.ErrSyntax,
+		"+1":   strconv.ErrSyntax,
+		"-1":   strconv.ErrSyntax,
+		"0x10": strconv.ErrSyntax,
+		"340282366920938463463374607431768211456": strconv.ErrRange,
+	} {
+		if _, err := ParseUint128(s); !errors.Is(err, expected) {
+			t.Fatalf("%q: expected %v but got %v", s, expected, err)
+		}
+	}
+}
+
+func TestParseInt128(t *testing.T) {
+	for _, s := range []string{"0", "-1", "+1", MinInt128.String(), MaxInt128.String(), "-12345678901234567890123"} {
+		if v, err := ParseInt128(s); err != nil || v.Big().Cmp(mustBig(s)) != 0 {
+			t.Fatalf("unexpected parse result of %s: %s, %v", s, v, err)
+		}
+	}
+
+	for s, expected := range map[string]error{
+		"":    strconv.ErrSyntax,
+		"1.5": strconv.ErrSyntax,
+		"170141183460469231731687303715884105728":  strconv.ErrRange,
+		"-170141183460469231731687303715884105729": strconv.ErrRange,
+	} {
+		if _, err := ParseInt128(s); !errors.Is(err, expected) {
+			t.Fatalf("%q: expected %v but got %v", s, expected, err)
+		}
+	}
+}
+
+func mustBig(s string) *big.Int {
+	b, _ := new(big.Int).SetString(s, 10)
+
+	return b
+}
+
+func TestUint128Division(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected division by zero")
+		}
+	}()
+
+	MaxUint128.Quo(Uint128{})
+}
+
+func TestInt128ByteOrder(t *testing.T) {
+	uuid := []byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+	u := Uint128{Hi: 0x123e4567e89b12d3, Lo: 0xa456426614174000}
+
+	if BE(uuid).ReadUint128() != u || Big.ReadUint128(uuid) != u {
+		t.Fatalf("unexpected big-endian decoding")
+	}
+
+	if LE(uuid).ReadUint128() != (Uint128{Hi: Swap64(u.Lo), Lo: Swap64(u.Hi)}) {
+		t.Fatalf("unexpected little-endian decoding")
+	}
+
+	tmp := make([]byte, 16)
+	for _, o := range []Order{Little, Big} {
+		i := Int128{Hi: -2, Lo: 42}
+		o.WriteInt128(tmp, i)
+
+		if o.ReadInt128(tmp) != i {
+			t.Fatalf("%v: unexpected round trip", o)
+		}
+
+		o.WriteUint128(tmp, u)
+		SwapBytes64(tmp)
+
+		if o.ReadUint128(tmp) == u {
+			t.Fatalf("%v: unexpected word order", o)
+		}
+
+		buf := NewBuffer(nil, o)
+		buf.AppendUint128(u)
+		buf.AppendInt128(i)
+
+		r := NewReader(buf.Bytes(), o)
+		if r.ReadUint128() != u || r.ReadInt128() != i {
+			t.Fatalf("%v: unexpected round trip", o)
+		}
+	}
+}
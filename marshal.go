@@ -0,0 +1,511 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A TypeError is returned by Marshal, Unmarshal and Size for a type which has no fixed size encoding, e.g. because
+// it contains a slice, a platform dependent int without a width tag or an invalid byteorder tag.
+type TypeError struct {
+	// Type is the offending type or the struct type which declares the offending field.
+	Type reflect.Type
+
+	// Field is the name of the offending struct field or empty.
+	Field string
+
+	// Reason describes why the type cannot be encoded.
+	Reason string
+}
+
+// Error returns a description including the type, the field and the reason.
+func (e *TypeError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("byteorder: cannot encode %v: %s", e.Type, e.Reason)
+	}
+
+	return fmt.Sprintf("byteorder: cannot encode field %s of %v: %s", e.Field, e.Type, e.Reason)
+}
+
+// codecKind enumerates the wire representations of a codec.
+type codecKind uint8
+
+const (
+	kindBool codecKind = iota
+	kindUint
+	kindInt
+	kindFloat16
+	kindBFloat16
+	kindFloat32
+	kindFloat64
+	kindUint128
+	kindInt128
+	kindArray
+	kindStruct
+)
+
+// A codec encodes and decodes values of a single type with a fixed size.
+type codec struct {
+	kind   codecKind
+	size   int     // encoded size in bytes
+	order  Order   // order of this value and its elements or fields, if fixed
+	fixed  bool    // true if the value does not inherit the order of its parent
+	elem   *codec  // codec of the elements of an array
+	fields []field // codecs of the fields of a struct
+}
+
+// A field describes the encoding of a single struct field.
+type field struct {
+	index int    // index of the field within its struct
+	skip  int    // padding bytes before the field
+	codec *codec // nil for blank fields, which are just padding
+}
+
+// A tag is the parsed representation of a byteorder struct tag like `byteorder:"i40,be,skip=3"`.
+type tag struct {
+	typ   string // wire type like u24, i40, f16 or empty for the default of the Go type
+	order Order
+	fixed bool
+	skip  int
+}
+
+//nolint:gochecknoglobals
+var (
+	codecs      sync.Map // reflect.Type => *codec
+	uint128Type = reflect.TypeOf(Uint128{})
+	int128Type  = reflect.TypeOf(Int128{})
+)
+
+// codecOf returns the cached codec of t or creates a new one.
+func codecOf(t reflect.Type) (*codec, error) {
+	if c, ok := codecs.Load(t); ok {
+		return c.(*codec), nil
+	}
+
+	c, err := newCodec(t, tag{})
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := codecs.LoadOrStore(t, c)
+
+	return actual.(*codec), nil
+}
+
+// parseTag parses the comma separated options of a byteorder struct tag.
+func parseTag(s string) (tag, error) {
+	var tg tag
+
+	for _, opt := range strings.Split(s, ",") {
+		switch {
+		case opt == "":
+		case opt == "le":
+			tg.order, tg.fixed = Little, true
+		case opt == "be":
+			tg.order, tg.fixed = Big, true
+		case strings.HasPrefix(opt, "skip="):
+			n, err := strconv.Atoi(opt[len("skip="):])
+			if err != nil || n < 0 {
+				return tg, fmt.Errorf("invalid option %q", opt)
+			}
+
+			tg.skip = n
+		case tg.typ != "":
+			return tg, fmt.Errorf("duplicate type %q", opt)
+		default:
+			tg.typ = opt
+		}
+	}
+
+	return tg, nil
+}
+
+// newCodec creates a codec for t, using the wire type and order of tg.
+func newCodec(t reflect.Type, tg tag) (*codec, error) {
+	c, reason := newCodecOf(t, tg)
+	if reason != "" {
+		return nil, &TypeError{Type: t, Reason: reason}
+	}
+
+	if c.kind == kindArray {
+		elem, err := newCodec(t.Elem(), tg)
+		if err != nil {
+			return nil, err
+		}
+
+		c.elem, c.size = elem, t.Len()*elem.size
+	}
+
+	if c.kind == kindStruct {
+		if err := c.addFields(t); err != nil {
+			return nil, err
+		}
+	}
+
+	c.order, c.fixed = tg.order, tg.fixed
+
+	return c, nil
+}
+
+// newCodecOf determines the kind and size of the codec for t or returns a reason why t is not supported.
+// The elements of arrays and the fields of structs are not yet resolved.
+func newCodecOf(t reflect.Type, tg tag) (*codec, string) {
+	switch {
+	case t == uint128Type:
+		return scalarCodec(kindUint128, 16, tg.typ, "u128") //nolint:gomnd
+	case t == int128Type:
+		return scalarCodec(kindInt128, 16, tg.typ, "i128") //nolint:gomnd
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return scalarCodec(kindBool, 1, tg.typ, "u8")
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint, reflect.Uintptr:
+		return intCodec(kindUint, t, tg.typ, "u")
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return intCodec(kindInt, t, tg.typ, "i")
+	case reflect.Float32, reflect.Float64:
+		switch tg.typ {
+		case "":
+			return floatCodec(t.Bits())
+		case "f16":
+			return &codec{kind: kindFloat16, size: 2}, ""
+		case "bf16":
+			return &codec{kind: kindBFloat16, size: 2}, ""
+		case "f32":
+			return floatCodec(32) //nolint:gomnd
+		case "f64":
+			return floatCodec(64) //nolint:gomnd
+		default:
+			return nil, "unsupported type " + strconv.Quote(tg.typ)
+		}
+	case reflect.Array:
+		return &codec{kind: kindArray}, ""
+	case reflect.Struct:
+		if tg.typ != "" {
+			return nil, "unsupported type " + strconv.Quote(tg.typ) + " for a struct"
+		}
+
+		return &codec{kind: kindStruct}, ""
+	default:
+		return nil, "unsupported kind " + t.Kind().String()
+	}
+}
+
+// scalarCodec returns a codec of the given kind and size, if typ is empty or equals def.
+func scalarCodec(kind codecKind, size int, typ, def string) (*codec, string) {
+	if typ != "" && typ != def {
+		return nil, "unsupported type " + strconv.Quote(typ)
+	}
+
+	return &codec{kind: kind, size: size}, ""
+}
+
+// floatCodec returns the codec for IEEE 754 binary32 or binary64.
+func floatCodec(bits int) (*codec, string) {
+	if bits == 32 { //nolint:gomnd
+		return &codec{kind: kindFloat32, size: 4}, "" //nolint:gomnd
+	}
+
+	return &codec{kind: kindFloat64, size: 8}, "" //nolint:gomnd
+}
+
+// intCodec returns the codec for an integer type t with a wire type like u24 or i40, where prefix is either u or i.
+// Platform dependent types require a wire type of at most 32 bit, so that the encoding is the same on all
+// platforms and matches the code of byteorder-gen. Otherwise the wire type must not be wider than t.
+func intCodec(kind codecKind, t reflect.Type, typ, prefix string) (*codec, string) {
+	platform := t.Kind() == reflect.Int || t.Kind() == reflect.Uint || t.Kind() == reflect.Uintptr
+
+	if typ == "" {
+		if platform {
+			return nil, "platform dependent kind " + t.Kind().String() + " requires a width tag"
+		}
+
+		return &codec{kind: kind, size: t.Bits() / 8}, "" //nolint:gomnd
+	}
+
+	bits, err := strconv.Atoi(strings.TrimPrefix(typ, prefix))
+	if err != nil || !strings.HasPrefix(typ, prefix) || bits < 8 || bits > 64 || bits%8 != 0 {
+		return nil, "unsupported type " + strconv.Quote(typ)
+	}
+
+	if platform && bits > 32 { //nolint:gomnd
+		return nil, "type " + strconv.Quote(typ) + " is wider than the portable 32 bit of " + t.Kind().String()
+	}
+
+	if bits > t.Bits() {
+		return nil, "type " + strconv.Quote(typ) + " is wider than " + t.Kind().String()
+	}
+
+	return &codec{kind: kind, size: bits / 8}, "" //nolint:gomnd
+}
+
+// addFields resolves the codecs of all exported and blank fields of the struct t. Fields tagged with "-" are
+// ignored.
+func (c *codec) addFields(t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		s := sf.Tag.Get("byteorder")
+
+		if s == "-" || sf.PkgPath != "" && sf.Name != "_" {
+			continue
+		}
+
+		tg, err := parseTag(s)
+		if err != nil {
+			return &TypeError{Type: t, Field: sf.Name, Reason: err.Error()}
+		}
+
+		fc, err := newCodec(sf.Type, tg)
+		if err != nil {
+			if te, _ := err.(*TypeError); te.Field == "" {
+				te.Type, te.Field = t, sf.Name
+			}
+
+			return err
+		}
+
+		f := field{index: i, skip: tg.skip, codec: fc}
+		if sf.Name == "_" {
+			f.skip, f.codec = f.skip+fc.size, nil
+		}
+
+		c.fields = append(c.fields, f)
+		c.size += f.skip
+
+		if f.codec != nil {
+			c.size += f.codec.size
+		}
+	}
+
+	return nil
+}
+
+// encode writes v into the first c.size bytes of b, using order unless the codec has a fixed order. Padding bytes
+// are left untouched.
+func (c *codec) encode(b []byte, v reflect.Value, order Order) error {
+	if c.fixed {
+		order = c.order
+	}
+
+	switch c.kind {
+	case kindBool:
+		b[0] = 0
+		if v.Bool() {
+			b[0] = 1
+		}
+	case kindUint:
+		x := v.Uint()
+		if x > maxUintOf(c.size) {
+			return &OverflowError{Value: x, Width: 8 * c.size} //nolint:gomnd
+		}
+
+		order.WriteUint(b, c.size, x)
+	case kindInt:
+		x := v.Int()
+		if err := intOverflow(x, minIntOf(c.size), maxIntOf(c.size), 8*c.size); err != nil { //nolint:gomnd
+			return err
+		}
+
+		order.WriteInt(b, c.size, x)
+	case kindFloat16:
+		order.WriteUint16(b, Float16bits(float32(v.Float())))
+	case kindBFloat16:
+		order.WriteUint16(b, BFloat16bits(float32(v.Float())))
+	case kindFloat32:
+		order.WriteFloat32(b, float32(v.Float()))
+	case kindFloat64:
+		order.WriteFloat64(b, v.Float())
+	case kindUint128:
+		order.WriteUint128(b, Uint128{Hi: v.Field(0).Uint(), Lo: v.Field(1).Uint()})
+	case kindInt128:
+		order.WriteInt128(b, Int128{Hi: v.Field(0).Int(), Lo: v.Field(1).Uint()})
+	case kindArray:
+		for i := 0; i < v.Len(); i++ {
+			if err := c.elem.encode(b[i*c.elem.size:], v.Index(i), order); err != nil {
+				return err
+			}
+		}
+	case kindStruct:
+		return c.encodeFields(b, v, order)
+	}
+
+	return nil
+}
+
+// encodeFields writes the fields of the struct v.
+func (c *codec) encodeFields(b []byte, v reflect.Value, order Order) error {
+	off := 0
+
+	for _, f := range c.fields {
+		off += f.skip
+
+		if f.codec == nil {
+			continue
+		}
+
+		if err := f.codec.encode(b[off:], v.Field(f.index), order); err != nil {
+			return err
+		}
+
+		off += f.codec.size
+	}
+
+	return nil
+}
+
+// decode reads the first c.size bytes of b into v, using order unless the codec has a fixed order.
+func (c *codec) decode(b []byte, v reflect.Value, order Order) {
+	if c.fixed {
+		order = c.order
+	}
+
+	switch c.kind {
+	case kindBool:
+		v.SetBool(b[0] != 0)
+	case kindUint:
+		v.SetUint(order.ReadUint(b, c.size))
+	case kindInt:
+		v.SetInt(order.ReadInt(b, c.size))
+	case kindFloat16:
+		v.SetFloat(float64(Float16frombits(order.ReadUint16(b))))
+	case kindBFloat16:
+		v.SetFloat(float64(BFloat16frombits(order.ReadUint16(b))))
+	case kindFloat32:
+		v.SetFloat(float64(order.ReadFloat32(b)))
+	case kindFloat64:
+		v.SetFloat(order.ReadFloat64(b))
+	case kindUint128:
+		x := order.ReadUint128(b)
+		v.Field(0).SetUint(x.Hi)
+		v.Field(1).SetUint(x.Lo)
+	case kindInt128:
+		x := order.ReadInt128(b)
+		v.Field(0).SetInt(x.Hi)
+		v.Field(1).SetUint(x.Lo)
+	case kindArray:
+		for i := 0; i < v.Len(); i++ {
+			c.elem.decode(b[i*c.elem.size:], v.Index(i), order)
+		}
+	case kindStruct:
+		off := 0
+
+		for _, f := range c.fields {
+			off += f.skip
+
+			if f.codec != nil {
+				f.codec.decode(b[off:], v.Field(f.index), order)
+				off += f.codec.size
+			}
+		}
+	}
+}
+
+// valueOf dereferences v, which must either be a value or a non-nil pointer.
+func valueOf(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return rv, &TypeError{Type: rv.Type(), Reason: "nil pointer"}
+		}
+
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() {
+		return rv, &TypeError{Type: nil, Reason: "nil value"}
+	}
+
+	return rv, nil
+}
+
+// Size returns the amount of bytes which Marshal produces and Unmarshal consumes for v, which may be a value or a
+// pointer. The size of a type is fixed.
+func Size(v interface{}) (int, error) {
+	rv, err := valueOf(v)
+	if err != nil {
+		return 0, err
+	}
+
+	c, err := codecOf(rv.Type())
+	if err != nil {
+		return 0, err
+	}
+
+	return c.size, nil
+}
+
+// Marshal encodes v, which may be a value or a pointer, using order for all values without an explicit order.
+// Supported are booleans, sized integers, floats, Uint128, Int128 and arrays and structs thereof. The encoding of
+// a struct field can be customized with a tag containing comma separated options:
+//
+//	`byteorder:"u24"`         encodes an unsigned integer with 8, 16, 24, 32, 40, 48, 56 or 64 bit
+//	`byteorder:"i40"`         encodes a signed integer with 8, 16, 24, 32, 40, 48, 56 or 64 bit
+//	`byteorder:"f16"`         encodes a float as binary16, bf16 as bfloat16 and f32 or f64 as binary32 or binary64
+//	`byteorder:"le"` or "be"  overrides the order of the field including all nested fields and elements
+//	`byteorder:"skip=3"`      inserts 3 zero bytes before the field
+//	`byteorder:"-"`           ignores the field
+//
+// The options of an array field apply to its elements. Unexported fields are ignored and blank fields are encoded
+// as zero padding. The platform dependent int, uint and uintptr require a width tag of at most 32 bit. Returns a
+// TypeError if v cannot be encoded and an OverflowError if a value does not fit into its tagged width.
+func Marshal(v interface{}, order Order) ([]byte, error) {
+	rv, err := valueOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := codecOf(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, c.size)
+	if err = c.encode(b, rv, order); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Unmarshal decodes the first Size(v) bytes of b into v, which must be a non-nil pointer, using order for all
+// values without an explicit order. See Marshal for the supported types and tags. Padding bytes are not validated.
+// Returns a ShortBufferError if b is too small.
+func Unmarshal(b []byte, v interface{}, order Order) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return &TypeError{Type: reflect.TypeOf(v), Reason: "requires a non-nil pointer"}
+	}
+
+	c, err := codecOf(rv.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	if len(b) < c.size {
+		return shortBuffer(c.size, len(b))
+	}
+
+	c.decode(b, rv.Elem(), order)
+
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+type marshalInner struct {
+	A uint16
+	B int32 `byteorder:"i24"`
+}
+
+type marshalRecord struct {
+	Flag    bool
+	Offset  uint64  `byteorder:"u40"`
+	Delta   int64   `byteorder:"i40,be"`
+	Half    float32 `byteorder:"f16"`
+	Brain   float64 `byteorder:"bf16,skip=2"`
+	_       [3]byte
+	Samples [2]int32     `byteorder:"i24"`
+	Inner   marshalInner `byteorder:"be"`
+	Tail    marshalInner
+	ID      Uint128
+	Signed  Int128 `byteorder:"le"`
+	Count   uint   `byteorder:"u16"`
+	Size    int    `byteorder:"i32"`
+	F32     float32
+	F64     float64 `byteorder:"f32"`
+	Wide    float32 `byteorder:"f64"`
+	Ignored string  `byteorder:"-"`
+	hidden  int
+}
+
+func TestMarshal(t *testing.T) {
+	v := marshalRecord{
+		Flag:    true,
+		Offset:  MaxUint40,
+		Delta:   MinInt40,
+		Half:    1.5,
+		Brain:   -2,
+		Samples: [2]int32{MinInt24, MaxInt24},
+		Inner:   marshalInner{A: 0x1234, B: -2},
+		Tail:    marshalInner{A: 0xABCD, B: 7},
+		ID:      Uint128{Hi: 1, Lo: 2},
+		Signed:  Int128{Hi: -1, Lo: 3},
+		Count:   uint(MaxUint16),
+		Size:    int(MinInt32),
+		F32:     0.25,
+		F64:     -0.5,
+		Wide:    3.25,
+		Ignored: "ignored",
+		hidden:  1,
+	}
+
+	for _, order := range []Order{Little, Big} {
+		buf := NewBuffer(nil, order)
+		be := NewBigEndianBuffer(nil)
+		le := NewLittleEndianBuffer(nil)
+
+		buf.AppendUint40(MaxUint40)
+		be.AppendInt40(MinInt40)
+		buf.AppendUint16(Float16bits(1.5))
+		buf.AppendUint16(0)
+		buf.AppendUint16(BFloat16bits(-2))
+		buf.AppendUint24(0)
+		buf.AppendInt24(MinInt24)
+		buf.AppendInt24(MaxInt24)
+		be.AppendUint16(0x1234)
+		be.AppendInt24(-2)
+		buf.AppendUint16(0xABCD)
+		buf.AppendInt24(7)
+		buf.AppendUint128(v.ID)
+		le.AppendInt128(v.Signed)
+		buf.AppendUint16(MaxUint16)
+		buf.AppendInt32(MinInt32)
+		buf.AppendFloat32(0.25)
+		buf.AppendFloat32(-0.5)
+		buf.AppendFloat64(3.25)
+
+		b := buf.Bytes()
+		expected := append([]byte{1}, b[:5]...)
+		expected = append(expected, be.Bytes()[:5]...)
+		expected = append(expected, b[5:20]...)
+		expected = append(expected, be.Bytes()[5:]...)
+		expected = append(expected, b[20:41]...)
+		expected = append(expected, le.Bytes()...)
+		expected = append(expected, b[41:]...)
+
+		for i := 0; i < 2; i++ {
+			actual, err := Marshal(&v, order)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(expected, actual) {
+				t.Fatalf("%v: expected\n%x but got\n%x", order, expected, actual)
+			}
+
+			if size, err := Size(v); err != nil || size != len(expected) {
+				t.Fatalf("%v: expected size %d but got %d (%v)", order, len(expected), size, err)
+			}
+
+			var decoded marshalRecord
+			if err := Unmarshal(append(actual, 0xFF), &decoded, order); err != nil {
+				t.Fatal(err)
+			}
+
+			expectedValue := v
+			expectedValue.Ignored, expectedValue.hidden = "", 0
+
+			if !reflect.DeepEqual(expectedValue, decoded) {
+				t.Fatalf("%v: expected %+v but got %+v", order, expectedValue, decoded)
+			}
+		}
+	}
+}
+
+func TestMarshalScalars(t *testing.T) {
+	b, err := Marshal([3]uint16{1, 2, 3}, Big)
+	if err != nil || !bytes.Equal(b, []byte{0, 1, 0, 2, 0, 3}) {
+		t.Fatalf("unexpected encoding %x (%v)", b, err)
+	}
+
+	var v int64
+	if err := Unmarshal([]byte{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, &v, Little); err != nil || v != -2 {
+		t.Fatalf("expected -2 but got %d (%v)", v, err)
+	}
+
+	if err := Unmarshal(b[:1], &v, Little); !errors.Is(err, ErrShortBuffer) {
+		t.Fatalf("expected short buffer but got %v", err)
+	}
+}
+
+func TestMarshalOverflow(t *testing.T) {
+	for _, v := range []interface{}{
+		struct {
+			A uint32 `byteorder:"u24"`
+		}{MaxUint24 + 1},
+		struct {
+			A [1]int64 `byteorder:"i48"`
+		}{[1]int64{MinInt48 - 1}},
+		struct {
+			A struct {
+				B int16 `byteorder:"i8"`
+			}
+		}{struct {
+			B int16 `byteorder:"i8"`
+		}{128}},
+	} {
+		if _, err := Marshal(v, Little); !errors.Is(err, ErrOverflow) {
+			t.Fatalf("%T: expected overflow but got %v", v, err)
+		}
+	}
+}
+
+func TestMarshalTypeErrors(t *testing.T) {
+	var nilPtr *marshalInner
+
+	for _, v := range []interface{}{
+		nil,
+		nilPtr,
+		"string",
+		[]byte{},
+		struct{ A []byte }{},
+		struct{ A int }{},
+		struct {
+			A int `byteorder:"i40"`
+		}{},
+		struct {
+			A uint `byteorder:"u64"`
+		}{},
+		struct {
+			A uint16 `byteorder:"u24"`
+		}{},
+		struct {
+			A uint16 `byteorder:"i16"`
+		}{},
+		struct {
+			A uint16 `byteorder:"u12"`
+		}{},
+		struct {
+			A uint16 `byteorder:"u16,u8"`
+		}{},
+		struct {
+			A uint16 `byteorder:"skip=-1"`
+		}{},
+		struct {
+			A float32 `byteorder:"f80"`
+		}{},
+		struct {
+			A bool `byteorder:"u16"`
+		}{},
+		struct {
+			A marshalInner `byteorder:"u16"`
+		}{},
+		struct{ A [2][]int }{},
+		struct{ A struct{ B uintptr } }{},
+	} {
+		var te *TypeError
+
+		if _, err := Marshal(v, Little); !errors.As(err, &te) || te.Error() == "" {
+			t.Fatalf("%T: expected type error but got %v", v, err)
+		}
+
+		if _, err := Size(v); !errors.As(err, &te) {
+			t.Fatalf("%T: expected type error but got %v", v, err)
+		}
+	}
+
+	var te *TypeError
+
+	if err := Unmarshal(nil, marshalInner{}, Little); !errors.As(err, &te) {
+		t.Fatalf("expected type error but got %v", err)
+	}
+
+	if err := Unmarshal(nil, nilPtr, Little); !errors.As(err, &te) {
+		t.Fatalf("expected type error but got %v", err)
+	}
+
+	if err := Unmarshal(nil, &struct{ A []byte }{}, Little); !errors.As(err, &te) || te.Field != "A" {
+		t.Fatalf("expected type error but got %v", err)
+	}
+}
@@ -0,0 +1,668 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// A Permutation describes an arbitrary byte order by the position of each byte within a value, which may differ
+// per width. Use NewPermutation to create one and View to obtain a ByteOrder.
+type Permutation struct {
+	shifts [9][8]uint8 // shifts[n][i] is the bit shift of the byte at position i of a value with n bytes
+	valid  [9]bool
+}
+
+// NewPermutation creates a Permutation from the given layouts, which map a width in bytes (1 to 8) to the
+// significance of the byte at each position, where 0 denotes the least significant byte. E.g. the layout
+// {4: {1, 0, 3, 2}} describes 32 bit values in the CDAB order and {8: {3, 2, 1, 0, 7, 6, 5, 4}} describes 64 bit
+// values as two big-endian 32 bit words with the least significant word first. Single bytes are always
+// supported. Returns an error if a width is invalid or a layout is not a permutation of its width.
+func NewPermutation(layouts map[int][]int) (*Permutation, error) {
+	p := &Permutation{}
+	p.valid[1] = true
+
+	for n, layout := range layouts {
+		if n < 1 || n > 8 || len(layout) != n {
+			return nil, fmt.Errorf("byteorder: invalid layout %v for width %d", layout, n)
+		}
+
+		var seen [8]bool
+
+		for i, sig := range layout {
+			if sig < 0 || sig >= n || seen[sig] {
+				return nil, fmt.Errorf("byteorder: invalid layout %v for width %d", layout, n)
+			}
+
+			seen[sig] = true
+			p.shifts[n][i] = uint8(8 * sig) //nolint:gomnd
+		}
+
+		p.valid[n] = true
+	}
+
+	return p, nil
+}
+
+// wordPermutation returns the permutation of all widths, where values are split into 16 bit words starting at
+// the least significant byte, so that the most significant word of an odd width consists of a single byte. The
+// words are ordered least significant first and the bytes within each word are big-endian, which is the CDAB
+// order. If mirror is true, all layouts are reversed, which results in the BADC order.
+func wordPermutation(mirror bool) *Permutation {
+	p := &Permutation{}
+
+	for n := 1; n <= 8; n++ {
+		p.valid[n] = true
+
+		for i := 0; i < n; i++ {
+			sig := i ^ 1
+			if sig == n {
+				sig = n - 1
+			}
+
+			pos := i
+			if mirror {
+				pos = n - 1 - i
+			}
+
+			p.shifts[n][pos] = uint8(8 * sig) //nolint:gomnd
+		}
+	}
+
+	return p
+}
+
+//nolint:gochecknoglobals
+var (
+	cdab = wordPermutation(false)
+	badc = wordPermutation(true)
+)
+
+// mustWidth panics, if p does not support the width n.
+func (p *Permutation) mustWidth(n int) {
+	if n < 1 || n > 8 || !p.valid[n] {
+		panic("byteorder: permutation does not support a width of " + strconv.Itoa(n) + " bytes")
+	}
+}
+
+// Supports returns true, if values of n bytes can be read and written.
+func (p *Permutation) Supports(n int) bool {
+	return n >= 1 && n <= 8 && p.valid[n]
+}
+
+// ReadUint reads the first n bytes of b. Panics when len(b) < n or if p does not support n.
+func (p *Permutation) ReadUint(b []byte, n int) uint64 {
+	p.mustWidth(n)
+
+	var v uint64
+
+	for i, b := range b[:n] {
+		v |= uint64(b) << p.shifts[n][i]
+	}
+
+	return v
+}
+
+// WriteUint writes the first n bytes of b. Panics when len(b) < n, if p does not support n or if v does not fit
+// into n bytes.
+func (p *Permutation) WriteUint(b []byte, n int, v uint64) {
+	p.mustWidth(n)
+	checkUint(v, maxUintOf(n), 8*n) //nolint:gomnd
+
+	for i := range b[:n] {
+		b[i] = byte(v >> p.shifts[n][i])
+	}
+}
+
+// ReadInt reads the first n bytes of b and sign extends the two's complement value. Panics when len(b) < n or if p
+// does not support n.
+func (p *Permutation) ReadInt(b []byte, n int) int64 {
+	return signExtend(p.ReadUint(b, n), uint(8*n)) //nolint:gomnd
+}
+
+// WriteInt writes the first n bytes of b. Panics when len(b) < n, if p does not support n or if v does not fit into
+// n bytes.
+func (p *Permutation) WriteInt(b []byte, n int, v int64) {
+	p.mustWidth(n)
+	checkInt(v, minIntOf(n), maxIntOf(n), 8*n) //nolint:gomnd
+	p.WriteUint(b, n, uint64(v)&maxUintOf(n))
+}
+
+// View returns a ByteOrder which reads and writes b using the permutation. Its methods panic if p does not support
+// the width.
+func (p *Permutation) View(b []byte) ByteOrder {
+	return permuted{b: b, p: p}
+}
+
+var _ ByteOrder = permuted{}
+
+// permuted implements ByteOrder for a Permutation.
+type permuted struct {
+	b []byte
+	p *Permutation
+}
+
+// ReadUint16 reads the first 2 bytes. Panics when len(b) < 2.
+func (o permuted) ReadUint16() uint16 {
+	return uint16(o.p.ReadUint(o.b, 2)) //nolint:gomnd
+}
+
+// WriteUint16 writes the first 2 bytes. Panics when len(b) < 2.
+func (o permuted) WriteUint16(v uint16) {
+	o.p.WriteUint(o.b, 2, uint64(v)) //nolint:gomnd
+}
+
+// ReadUint24 reads the first 3 bytes. Panics when len(b) < 3.
+func (o permuted) ReadUint24() uint32 {
+	return uint32(o.p.ReadUint(o.b, 3)) //nolint:gomnd
+}
+
+// WriteUint24 writes the first 3 bytes. Panics when len(b) < 3.
+func (o permuted) WriteUint24(v uint32) {
+	o.p.WriteUint(o.b, 3, uint64(v&MaxUint24)) //nolint:gomnd
+}
+
+// ReadUint32 reads the first 4 bytes. Panics when len(b) < 4.
+func (o permuted) ReadUint32() uint32 {
+	return uint32(o.p.ReadUint(o.b, 4)) //nolint:gomnd
+}
+
+// WriteUint32 writes the first 4 bytes. Panics when len(b) < 4.
+func (o permuted) WriteUint32(v uint32) {
+	o.p.WriteUint(o.b, 4, uint64(v)) //nolint:gomnd
+}
+
+// ReadUint40 reads the first 5 bytes. Panics when len(b) < 5.
+func (o permuted) ReadUint40() uint64 {
+	return o.p.ReadUint(o.b, 5) //nolint:gomnd
+}
+
+// WriteUint40 writes the first 5 bytes. Panics when len(b) < 5.
+func (o permuted) WriteUint40(v uint64) {
+	o.p.WriteUint(o.b, 5, uint64(v)&MaxUint40) //nolint:gomnd
+}
+
+// ReadUint48 reads the first 6 bytes. Panics when len(b) < 6.
+func (o permuted) ReadUint48() uint64 {
+	return o.p.ReadUint(o.b, 6) //nolint:gomnd
+}
+
+// WriteUint48 writes the first 6 bytes. Panics when len(b) < 6.
+func (o permuted) WriteUint48(v uint64) {
+	o.p.WriteUint(o.b, 6, uint64(v)&MaxUint48) //nolint:gomnd
+}
+
+// ReadUint56 reads the first 7 bytes. Panics when len(b) < 7.
+func (o permuted) ReadUint56() uint64 {
+	return o.p.ReadUint(o.b, 7) //nolint:gomnd
+}
+
+// WriteUint56 writes the first 7 bytes. Panics when len(b) < 7.
+func (o permuted) WriteUint56(v uint64) {
+	o.p.WriteUint(o.b, 7, uint64(v)&MaxUint56) //nolint:gomnd
+}
+
+// ReadUint64 reads the first 8 bytes. Panics when len(b) < 8.
+func (o permuted) ReadUint64() uint64 {
+	return o.p.ReadUint(o.b, 8) //nolint:gomnd
+}
+
+// WriteUint64 writes the first 8 bytes. Panics when len(b) < 8.
+func (o permuted) WriteUint64(v uint64) {
+	o.p.WriteUint(o.b, 8, v) //nolint:gomnd
+}
+
+// ReadInt16 reads the first 2 bytes as a signed two's complement integer. Panics when len(b) < 2.
+func (o permuted) ReadInt16() int16 {
+	return int16(o.p.ReadInt(o.b, 2)) //nolint:gomnd
+}
+
+// WriteInt16 writes the first 2 bytes. Panics when len(b) < 2.
+func (o permuted) WriteInt16(v int16) {
+	o.p.WriteInt(o.b, 2, int64(v)) //nolint:gomnd
+}
+
+// ReadInt24 reads the first 3 bytes as a signed two's complement integer. Panics when len(b) < 3.
+func (o permuted) ReadInt24() int32 {
+	return int32(o.p.ReadInt(o.b, 3)) //nolint:gomnd
+}
+
+// WriteInt24 writes the first 3 bytes. Panics when len(b) < 3 or if v is not within
+// [MinInt24, MaxInt24].
+func (o permuted) WriteInt24(v int32) {
+	o.p.WriteInt(o.b, 3, int64(v)) //nolint:gomnd
+}
+
+// ReadInt32 reads the first 4 bytes as a signed two's complement integer. Panics when len(b) < 4.
+func (o permuted) ReadInt32() int32 {
+	return int32(o.p.ReadInt(o.b, 4)) //nolint:gomnd
+}
+
+// WriteInt32 writes the first 4 bytes. Panics when len(b) < 4.
+func (o permuted) WriteInt32(v int32) {
+	o.p.WriteInt(o.b, 4, int64(v)) //nolint:gomnd
+}
+
+// ReadInt40 reads the first 5 bytes as a signed two's complement integer. Panics when len(b) < 5.
+func (o permuted) ReadInt40() int64 {
+	return o.p.ReadInt(o.b, 5) //nolint:gomnd
+}
+
+// WriteInt40 writes the first 5 bytes. Panics when len(b) < 5 or if v is not within
+// [MinInt40, MaxInt40].
+func (o permuted) WriteInt40(v int64) {
+	o.p.WriteInt(o.b, 5, v) //nolint:gomnd
+}
+
+// ReadInt48 reads the first 6 bytes as a signed two's complement integer. Panics when len(b) < 6.
+func (o permuted) ReadInt48() int64 {
+	return o.p.ReadInt(o.b, 6) //nolint:gomnd
+}
+
+// WriteInt48 writes the first 6 bytes. Panics when len(b) < 6 or if v is not within
+// [MinInt48, MaxInt48].
+func (o permuted) WriteInt48(v int64) {
+	o.p.WriteInt(o.b, 6, v) //nolint:gomnd
+}
+
+// ReadInt56 reads the first 7 bytes as a signed two's complement integer. Panics when len(b) < 7.
+func (o permuted) ReadInt56() int64 {
+	return o.p.ReadInt(o.b, 7) //nolint:gomnd
+}
+
+// WriteInt56 writes the first 7 bytes. Panics when len(b) < 7 or if v is not within
+// [MinInt56, MaxInt56].
+func (o permuted) WriteInt56(v int64) {
+	o.p.WriteInt(o.b, 7, v) //nolint:gomnd
+}
+
+// ReadInt64 reads the first 8 bytes as a signed two's complement integer. Panics when len(b) < 8.
+func (o permuted) ReadInt64() int64 {
+	return o.p.ReadInt(o.b, 8) //nolint:gomnd
+}
+
+// WriteInt64 writes the first 8 bytes. Panics when len(b) < 8.
+func (o permuted) WriteInt64(v int64) {
+	o.p.WriteInt(o.b, 8, v) //nolint:gomnd
+}
+
+// ReadFloat32 reads 4 bytes and interprets them as a float32 IEEE 754 4 byte bit sequence. Panics when len(b) < 4.
+func (o permuted) ReadFloat32() float32 {
+	return math.Float32frombits(o.ReadUint32())
+}
+
+// WriteFloat32 writes a float32 IEEE 754 4 byte bit sequence. Panics when len(b) < 4.
+func (o permuted) WriteFloat32(v float32) {
+	o.WriteUint32(math.Float32bits(v))
+}
+
+// ReadFloat64 reads 8 bytes and interprets them as a float64 IEEE 754 8 byte bit sequence. Panics when len(b) < 8.
+func (o permuted) ReadFloat64() float64 {
+	return math.Float64frombits(o.ReadUint64())
+}
+
+// WriteFloat64 writes a float64 IEEE 754 8 byte bit sequence. Panics when len(b) < 8.
+func (o permuted) WriteFloat64(v float64) {
+	o.WriteUint64(math.Float64bits(v))
+}
+
+var _ ByteOrder = CDAB(nil)
+
+// CDAB defines the word-swapped serialization, e.g. used by many Modbus devices for 32 bit values. Values are split
+// into 16 bit words, which are ordered least significant word first, while the bytes within each word are
+// big-endian. The 32 bit value 0xAABBCCDD is stored as CC DD AA BB and 64 bit values as GH EF CD AB. For odd widths,
+// the most significant word consists of a single byte, e.g. 0xAABBCC is stored as BB CC AA.
+type CDAB []byte
+
+// ReadUint16 reads the first 2 bytes. Panics when len(b) < 2.
+func (b CDAB) ReadUint16() uint16 {
+	return uint16(cdab.ReadUint(b, 2)) //nolint:gomnd
+}
+
+// WriteUint16 writes the first 2 bytes. Panics when len(b) < 2.
+func (b CDAB) WriteUint16(v uint16) {
+	cdab.WriteUint(b, 2, uint64(v)) //nolint:gomnd
+}
+
+// ReadUint24 reads the first 3 bytes. Panics when len(b) < 3.
+func (b CDAB) ReadUint24() uint32 {
+	return uint32(cdab.ReadUint(b, 3)) //nolint:gomnd
+}
+
+// WriteUint24 writes the first 3 bytes. Panics when len(b) < 3.
+func (b CDAB) WriteUint24(v uint32) {
+	cdab.WriteUint(b, 3, uint64(v&MaxUint24)) //nolint:gomnd
+}
+
+// ReadUint32 reads the first 4 bytes. Panics when len(b) < 4.
+func (b CDAB) ReadUint32() uint32 {
+	return uint32(cdab.ReadUint(b, 4)) //nolint:gomnd
+}
+
+// WriteUint32 writes the first 4 bytes. Panics when len(b) < 4.
+func (b CDAB) WriteUint32(v uint32) {
+	cdab.WriteUint(b, 4, uint64(v)) //nolint:gomnd
+}
+
+// ReadUint40 reads the first 5 bytes. Panics when len(b) < 5.
+func (b CDAB) ReadUint40() uint64 {
+	return cdab.ReadUint(b, 5) //nolint:gomnd
+}
+
+// WriteUint40 writes the first 5 bytes. Panics when len(b) < 5.
+func (b CDAB) WriteUint40(v uint64) {
+	cdab.WriteUint(b, 5, uint64(v)&MaxUint40) //nolint:gomnd
+}
+
+// ReadUint48 reads the first 6 bytes. Panics when len(b) < 6.
+func (b CDAB) ReadUint48() uint64 {
+	return cdab.ReadUint(b, 6) //nolint:gomnd
+}
+
+// WriteUint48 writes the first 6 bytes. Panics when len(b) < 6.
+func (b CDAB) WriteUint48(v uint64) {
+	cdab.WriteUint(b, 6, uint64(v)&MaxUint48) //nolint:gomnd
+}
+
+// ReadUint56 reads the first 7 bytes. Panics when len(b) < 7.
+func (b CDAB) ReadUint56() uint64 {
+	return cdab.ReadUint(b, 7) //nolint:gomnd
+}
+
+// WriteUint56 writes the first 7 bytes. Panics when len(b) < 7.
+func (b CDAB) WriteUint56(v uint64) {
+	cdab.WriteUint(b, 7, uint64(v)&MaxUint56) //nolint:gomnd
+}
+
+// ReadUint64 reads the first 8 bytes. Panics when len(b) < 8.
+func (b CDAB) ReadUint64() uint64 {
+	return cdab.ReadUint(b, 8) //nolint:gomnd
+}
+
+// WriteUint64 writes the first 8 bytes. Panics when len(b) < 8.
+func (b CDAB) WriteUint64(v uint64) {
+	cdab.WriteUint(b, 8, v) //nolint:gomnd
+}
+
+// ReadInt16 reads the first 2 bytes as a signed two's complement integer. Panics when len(b) < 2.
+func (b CDAB) ReadInt16() int16 {
+	return int16(cdab.ReadInt(b, 2)) //nolint:gomnd
+}
+
+// WriteInt16 writes the first 2 bytes. Panics when len(b) < 2.
+func (b CDAB) WriteInt16(v int16) {
+	cdab.WriteInt(b, 2, int64(v)) //nolint:gomnd
+}
+
+// ReadInt24 reads the first 3 bytes as a signed two's complement integer. Panics when len(b) < 3.
+func (b CDAB) ReadInt24() int32 {
+	return int32(cdab.ReadInt(b, 3)) //nolint:gomnd
+}
+
+// WriteInt24 writes the first 3 bytes. Panics when len(b) < 3 or if v is not within
+// [MinInt24, MaxInt24].
+func (b CDAB) WriteInt24(v int32) {
+	cdab.WriteInt(b, 3, int64(v)) //nolint:gomnd
+}
+
+// ReadInt32 reads the first 4 bytes as a signed two's complement integer. Panics when len(b) < 4.
+func (b CDAB) ReadInt32() int32 {
+	return int32(cdab.ReadInt(b, 4)) //nolint:gomnd
+}
+
+// WriteInt32 writes the first 4 bytes. Panics when len(b) < 4.
+func (b CDAB) WriteInt32(v int32) {
+	cdab.WriteInt(b, 4, int64(v)) //nolint:gomnd
+}
+
+// ReadInt40 reads the first 5 bytes as a signed two's complement integer. Panics when len(b) < 5.
+func (b CDAB) ReadInt40() int64 {
+	return cdab.ReadInt(b, 5) //nolint:gomnd
+}
+
+// WriteInt40 writes the first 5 bytes. Panics when len(b) < 5 or if v is not within
+// [MinInt40, MaxInt40].
+func (b CDAB) WriteInt40(v int64) {
+	cdab.WriteInt(b, 5, v) //nolint:gomnd
+}
+
+// ReadInt48 reads the first 6 bytes as a signed two's complement integer. Panics when len(b) < 6.
+func (b CDAB) ReadInt48() int64 {
+	return cdab.ReadInt(b, 6) //nolint:gomnd
+}
+
+// WriteInt48 writes the first 6 bytes. Panics when len(b) < 6 or if v is not within
+// [MinInt48, MaxInt48].
+func (b CDAB) WriteInt48(v int64) {
+	cdab.WriteInt(b, 6, v) //nolint:gomnd
+}
+
+// ReadInt56 reads the first 7 bytes as a signed two's complement integer. Panics when len(b) < 7.
+func (b CDAB) ReadInt56() int64 {
+	return cdab.ReadInt(b, 7) //nolint:gomnd
+}
+
+// WriteInt56 writes the first 7 bytes. Panics when len(b) < 7 or if v is not within
+// [MinInt56, MaxInt56].
+func (b CDAB) WriteInt56(v int64) {
+	cdab.WriteInt(b, 7, v) //nolint:gomnd
+}
+
+// ReadInt64 reads the first 8 bytes as a signed two's complement integer. Panics when len(b) < 8.
+func (b CDAB) ReadInt64() int64 {
+	return cdab.ReadInt(b, 8) //nolint:gomnd
+}
+
+// WriteInt64 writes the first 8 bytes. Panics when len(b) < 8.
+func (b CDAB) WriteInt64(v int64) {
+	cdab.WriteInt(b, 8, v) //nolint:gomnd
+}
+
+// ReadFloat32 reads 4 bytes and interprets them as a float32 IEEE 754 4 byte bit sequence. Panics when len(b) < 4.
+func (b CDAB) ReadFloat32() float32 {
+	return math.Float32frombits(b.ReadUint32())
+}
+
+// WriteFloat32 writes a float32 IEEE 754 4 byte bit sequence. Panics when len(b) < 4.
+func (b CDAB) WriteFloat32(v float32) {
+	b.WriteUint32(math.Float32bits(v))
+}
+
+// ReadFloat64 reads 8 bytes and interprets them as a float64 IEEE 754 8 byte bit sequence. Panics when len(b) < 8.
+func (b CDAB) ReadFloat64() float64 {
+	return math.Float64frombits(b.ReadUint64())
+}
+
+// WriteFloat64 writes a float64 IEEE 754 8 byte bit sequence. Panics when len(b) < 8.
+func (b CDAB) WriteFloat64(v float64) {
+	b.WriteUint64(math.Float64bits(v))
+}
+
+var _ ByteOrder = BADC(nil)
+
+// BADC defines the byte-swapped serialization, also known as the middle-endian order of the PDP-11. Values are split
+// into 16 bit words, which are ordered most significant word first, while the bytes within each word are
+// little-endian. The 32 bit value 0xAABBCCDD is stored as BB AA DD CC and 64 bit values as BA DC FE HG. For odd
+// widths, the most significant word consists of a single byte, e.g. 0xAABBCC is stored as AA CC BB. It is the
+// mirror image of CDAB.
+type BADC []byte
+
+// ReadUint16 reads the first 2 bytes. Panics when len(b) < 2.
+func (b BADC) ReadUint16() uint16 {
+	return uint16(badc.ReadUint(b, 2)) //nolint:gomnd
+}
+
+// WriteUint16 writes the first 2 bytes. Panics when len(b) < 2.
+func (b BADC) WriteUint16(v uint16) {
+	badc.WriteUint(b, 2, uint64(v)) //nolint:gomnd
+}
+
+// ReadUint24 reads the first 3 bytes. Panics when len(b) < 3.
+func (b BADC) ReadUint24() uint32 {
+	return uint32(badc.ReadUint(b, 3)) //nolint:gomnd
+}
+
+// WriteUint24 writes the first 3 bytes. Panics when len(b) < 3.
+func (b BADC) WriteUint24(v uint32) {
+	badc.WriteUint(b, 3, uint64(v&MaxUint24)) //nolint:gomnd
+}
+
+// ReadUint32 reads the first 4 bytes. Panics when len(b) < 4.
+func (b BADC) ReadUint32() uint32 {
+	return uint32(badc.ReadUint(b, 4)) //nolint:gomnd
+}
+
+// WriteUint32 writes the first 4 bytes. Panics when len(b) < 4.
+func (b BADC) WriteUint32(v uint32) {
+	badc.WriteUint(b, 4, uint64(v)) //nolint:gomnd
+}
+
+// ReadUint40 reads the first 5 bytes. Panics when len(b) < 5.
+func (b BADC) ReadUint40() uint64 {
+	return badc.ReadUint(b, 5) //nolint:gomnd
+}
+
+// WriteUint40 writes the first 5 bytes. Panics when len(b) < 5.
+func (b BADC) WriteUint40(v uint64) {
+	badc.WriteUint(b, 5, uint64(v)&MaxUint40) //nolint:gomnd
+}
+
+// ReadUint48 reads the first 6 bytes. Panics when len(b) < 6.
+func (b BADC) ReadUint48() uint64 {
+	return badc.ReadUint(b, 6) //nolint:gomnd
+}
+
+// WriteUint48 writes the first 6 bytes. Panics when len(b) < 6.
+func (b BADC) WriteUint48(v uint64) {
+	badc.WriteUint(b, 6, uint64(v)&MaxUint48) //nolint:gomnd
+}
+
+// ReadUint56 reads the first 7 bytes. Panics when len(b) < 7.
+func (b BADC) ReadUint56() uint64 {
+	return badc.ReadUint(b, 7) //nolint:gomnd
+}
+
+// WriteUint56 writes the first 7 bytes. Panics when len(b) < 7.
+func (b BADC) WriteUint56(v uint64) {
+	badc.WriteUint(b, 7, uint64(v)&MaxUint56) //nolint:gomnd
+}
+
+// ReadUint64 reads the first 8 bytes. Panics when len(b) < 8.
+func (b BADC) ReadUint64() uint64 {
+	return badc.ReadUint(b, 8) //nolint:gomnd
+}
+
+// WriteUint64 writes the first 8 bytes. Panics when len(b) < 8.
+func (b BADC) WriteUint64(v uint64) {
+	badc.WriteUint(b, 8, v) //nolint:gomnd
+}
+
+// ReadInt16 reads the first 2 bytes as a signed two's complement integer. Panics when len(b) < 2.
+func (b BADC) ReadInt16() int16 {
+	return int16(badc.ReadInt(b, 2)) //nolint:gomnd
+}
+
+// WriteInt16 writes the first 2 bytes. Panics when len(b) < 2.
+func (b BADC) WriteInt16(v int16) {
+	badc.WriteInt(b, 2, int64(v)) //nolint:gomnd
+}
+
+// ReadInt24 reads the first 3 bytes as a signed two's complement integer. Panics when len(b) < 3.
+func (b BADC) ReadInt24() int32 {
+	return int32(badc.ReadInt(b, 3)) //nolint:gomnd
+}
+
+// WriteInt24 writes the first 3 bytes. Panics when len(b) < 3 or if v is not within
+// [MinInt24, MaxInt24].
+func (b BADC) WriteInt24(v int32) {
+	badc.WriteInt(b, 3, int64(v)) //nolint:gomnd
+}
+
+// ReadInt32 reads the first 4 bytes as a signed two's complement integer. Panics when len(b) < 4.
+func (b BADC) ReadInt32() int32 {
+	return int32(badc.ReadInt(b, 4)) //nolint:gomnd
+}
+
+// WriteInt32 writes the first 4 bytes. Panics when len(b) < 4.
+func (b BADC) WriteInt32(v int32) {
+	badc.WriteInt(b, 4, int64(v)) //nolint:gomnd
+}
+
+// ReadInt40 reads the first 5 bytes as a signed two's complement integer. Panics when len(b) < 5.
+func (b BADC) ReadInt40() int64 {
+	return badc.ReadInt(b, 5) //nolint:gomnd
+}
+
+// WriteInt40 writes the first 5 bytes. Panics when len(b) < 5 or if v is not within
+// [MinInt40, MaxInt40].
+func (b BADC) WriteInt40(v int64) {
+	badc.WriteInt(b, 5, v) //nolint:gomnd
+}
+
+// ReadInt48 reads the first 6 bytes as a signed two's complement integer. Panics when len(b) < 6.
+func (b BADC) ReadInt48() int64 {
+	return badc.ReadInt(b, 6) //nolint:gomnd
+}
+
+// WriteInt48 writes the first 6 bytes. Panics when len(b) < 6 or if v is not within
+// [MinInt48, MaxInt48].
+func (b BADC) WriteInt48(v int64) {
+	badc.WriteInt(b, 6, v) //nolint:gomnd
+}
+
+// ReadInt56 reads the first 7 bytes as a signed two's complement integer. Panics when len(b) < 7.
+func (b BADC) ReadInt56() int64 {
+	return badc.ReadInt(b, 7) //nolint:gomnd
+}
+
+// WriteInt56 writes the first 7 bytes. Panics when len(b) < 7 or if v is not within
+// [MinInt56, MaxInt56].
+func (b BADC) WriteInt56(v int64) {
+	badc.WriteInt(b, 7, v) //nolint:gomnd
+}
+
+// ReadInt64 reads the first 8 bytes as a signed two's complement integer. Panics when len(b) < 8.
+func (b BADC) ReadInt64() int64 {
+	return badc.ReadInt(b, 8) //nolint:gomnd
+}
+
+// WriteInt64 writes the first 8 bytes. Panics when len(b) < 8.
+func (b BADC) WriteInt64(v int64) {
+	badc.WriteInt(b, 8, v) //nolint:gomnd
+}
+
+// ReadFloat32 reads 4 bytes and interprets them as a float32 IEEE 754 4 byte bit sequence. Panics when len(b) < 4.
+func (b BADC) ReadFloat32() float32 {
+	return math.Float32frombits(b.ReadUint32())
+}
+
+// WriteFloat32 writes a float32 IEEE 754 4 byte bit sequence. Panics when len(b) < 4.
+func (b BADC) WriteFloat32(v float32) {
+	b.WriteUint32(math.Float32bits(v))
+}
+
+// ReadFloat64 reads 8 bytes and interprets them as a float64 IEEE 754 8 byte bit sequence. Panics when len(b) < 8.
+func (b BADC) ReadFloat64() float64 {
+	return math.Float64frombits(b.ReadUint64())
+}
+
+// WriteFloat64 writes a float64 IEEE 754 8 byte bit sequence. Panics when len(b) < 8.
+func (b BADC) WriteFloat64(v float64) {
+	b.WriteUint64(math.Float64bits(v))
+}
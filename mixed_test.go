@@ -0,0 +1,256 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+//nolint:gochecknoglobals
+var uintMethods = []struct {
+	n     int
+	write func(ByteOrder, uint64)
+	read  func(ByteOrder) uint64
+}{
+	{2, func(b ByteOrder, v uint64) { b.WriteUint16(uint16(v)) },
+		func(b ByteOrder) uint64 { return uint64(b.ReadUint16()) }},
+	{3, func(b ByteOrder, v uint64) { b.WriteUint24(uint32(v)) },
+		func(b ByteOrder) uint64 { return uint64(b.ReadUint24()) }},
+	{4, func(b ByteOrder, v uint64) { b.WriteUint32(uint32(v)) },
+		func(b ByteOrder) uint64 { return uint64(b.ReadUint32()) }},
+	{5, ByteOrder.WriteUint40, ByteOrder.ReadUint40},
+	{6, ByteOrder.WriteUint48, ByteOrder.ReadUint48},
+	{7, ByteOrder.WriteUint56, ByteOrder.ReadUint56},
+	{8, ByteOrder.WriteUint64, ByteOrder.ReadUint64},
+}
+
+//nolint:gochecknoglobals
+var intMethods = []struct {
+	n     int
+	write func(ByteOrder, int64)
+	read  func(ByteOrder) int64
+}{
+	{2, func(b ByteOrder, v int64) { b.WriteInt16(int16(v)) },
+		func(b ByteOrder) int64 { return int64(b.ReadInt16()) }},
+	{3, func(b ByteOrder, v int64) { b.WriteInt24(int32(v)) },
+		func(b ByteOrder) int64 { return int64(b.ReadInt24()) }},
+	{4, func(b ByteOrder, v int64) { b.WriteInt32(int32(v)) },
+		func(b ByteOrder) int64 { return int64(b.ReadInt32()) }},
+	{5, ByteOrder.WriteInt40, ByteOrder.ReadInt40},
+	{6, ByteOrder.WriteInt48, ByteOrder.ReadInt48},
+	{7, ByteOrder.WriteInt56, ByteOrder.ReadInt56},
+	{8, ByteOrder.WriteInt64, ByteOrder.ReadInt64},
+}
+
+func TestMixedOrders(t *testing.T) {
+	const v = 0x1122334455667788
+
+	tests := []struct {
+		order    func([]byte) ByteOrder
+		expected []string
+	}{
+		{func(b []byte) ByteOrder { return CDAB(b) }, []string{
+			"7788", "778866", "77885566", "7788556644", "778855663344", "77885566334422", "7788556633441122",
+		}},
+		{func(b []byte) ByteOrder { return BADC(b) }, []string{
+			"8877", "668877", "66558877", "4466558877", "443366558877", "22443366558877", "2211443366558877",
+		}},
+	}
+
+	for _, tt := range tests {
+		for i, m := range uintMethods {
+			tmp := make([]byte, m.n)
+			view := tt.order(tmp)
+			expected := uint64(v) & (MaxUint64 >> (64 - 8*m.n))
+
+			m.write(view, expected)
+
+			if hex.EncodeToString(tmp) != tt.expected[i] {
+				t.Fatalf("%T %d: expected %s but got %x", view, m.n, tt.expected[i], tmp)
+			}
+
+			if actual := m.read(view); actual != expected {
+				t.Fatalf("%T %d: expected %x but got %x", view, m.n, expected, actual)
+			}
+		}
+	}
+}
+
+func TestMixedOrdersSigned(t *testing.T) {
+	for _, order := range []func([]byte) ByteOrder{
+		func(b []byte) ByteOrder { return CDAB(b) },
+		func(b []byte) ByteOrder { return BADC(b) },
+	} {
+		tmp := order(make([]byte, 8))
+
+		for _, m := range intMethods {
+			min := int64(-1) << (8*m.n - 1)
+
+			for _, v := range []int64{min, -1, ^min} {
+				m.write(tmp, v)
+
+				if actual := m.read(tmp); actual != v {
+					t.Fatalf("%T %d: expected %d but got %d", tmp, m.n, v, actual)
+				}
+			}
+		}
+
+		tmp.WriteUint24(MaxUint32)
+		tmp.WriteUint40(MaxUint64)
+
+		if tmp.ReadUint40() != MaxUint40 || tmp.ReadUint24() != MaxUint24 {
+			t.Fatalf("%T: expected truncation but got %x", tmp, tmp)
+		}
+
+		tmp.WriteFloat32(math.Pi)
+
+		if tmp.ReadFloat32() != math.Pi {
+			t.Fatalf("%T: expected pi but got %v", tmp, tmp.ReadFloat32())
+		}
+
+		tmp.WriteFloat64(math.E)
+
+		if tmp.ReadFloat64() != math.E {
+			t.Fatalf("%T: expected e but got %v", tmp, tmp.ReadFloat64())
+		}
+
+		assertOverflow(t, func() { tmp.WriteInt24(MaxInt24 + 1) })
+		assertOverflow(t, func() { tmp.WriteInt56(MinInt56 - 1) })
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	little, big := map[int][]int{}, map[int][]int{}
+
+	for n := 2; n <= 8; n++ {
+		for i := 0; i < n; i++ {
+			little[n] = append(little[n], i)
+			big[n] = append(big[n], n-1-i)
+		}
+	}
+
+	tests := []struct {
+		layouts map[int][]int
+		order   func([]byte) ByteOrder
+	}{
+		{little, func(b []byte) ByteOrder { return LE(b) }},
+		{big, func(b []byte) ByteOrder { return BE(b) }},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPermutation(tt.layouts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actualBuf, expectedBuf := make([]byte, 8), make([]byte, 8)
+		actual, expected := p.View(actualBuf), tt.order(expectedBuf)
+		source, expectedSource := p.View(src), tt.order(src)
+
+		for _, m := range uintMethods {
+			v := m.read(source)
+			if e := m.read(expectedSource); e != v {
+				t.Fatalf("Uint%d: expected %v but got %v", 8*m.n, e, v)
+			}
+
+			m.write(actual, v)
+			m.write(expected, v)
+			assertPermuted(t, actualBuf, expectedBuf)
+		}
+
+		for _, m := range intMethods {
+			v := m.read(source)
+			if e := m.read(expectedSource); e != v {
+				t.Fatalf("Int%d: expected %v but got %v", 8*m.n, e, v)
+			}
+
+			m.write(actual, v)
+			m.write(expected, v)
+			assertPermuted(t, actualBuf, expectedBuf)
+		}
+
+		if source.ReadFloat32() != expectedSource.ReadFloat32() || source.ReadFloat64() != expectedSource.ReadFloat64() {
+			t.Fatalf("unexpected floats")
+		}
+
+		actual.WriteFloat32(source.ReadFloat32())
+		expected.WriteFloat32(source.ReadFloat32())
+		assertPermuted(t, actualBuf, expectedBuf)
+
+		actual.WriteFloat64(source.ReadFloat64())
+		expected.WriteFloat64(source.ReadFloat64())
+		assertPermuted(t, actualBuf, expectedBuf)
+	}
+}
+
+func assertPermuted(t *testing.T, actual, expected []byte) {
+	t.Helper()
+
+	if !bytes.Equal(actual, expected) {
+		t.Fatalf("expected %x but got %x", expected, actual)
+	}
+}
+
+func TestPermutationWidths(t *testing.T) {
+	// 64 bit values as two big-endian 32 bit words, least significant word first
+	p, err := NewPermutation(map[int][]int{8: {3, 2, 1, 0, 7, 6, 5, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmp := make([]byte, 8)
+	p.WriteInt(tmp, 8, 0x1122334455667788)
+
+	if hex.EncodeToString(tmp) != "5566778811223344" || p.ReadInt(tmp, 8) != 0x1122334455667788 {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	p.WriteUint(tmp, 1, 0xFF)
+
+	if p.ReadUint(tmp, 1) != 0xFF || !p.Supports(1) || !p.Supports(8) || p.Supports(4) || p.Supports(9) {
+		t.Fatalf("unexpected encoding %x", tmp)
+	}
+
+	for _, f := range []func(){
+		func() { p.View(tmp).ReadUint32() },
+		func() { p.ReadUint(tmp, 0) },
+		func() { p.WriteInt(tmp, 9, 0) },
+	} {
+		assertWidthPanics(t, 0, f)
+	}
+
+	assertOverflow(t, func() { p.WriteUint(tmp, 1, 0x100) })
+	assertOverflow(t, func() { p.WriteInt(tmp, 1, -129) })
+
+	for _, layouts := range []map[int][]int{
+		{0: {}},
+		{9: {0, 1, 2, 3, 4, 5, 6, 7, 8}},
+		{2: {0}},
+		{2: {1, 1}},
+		{3: {0, 1, 3}},
+		{3: {0, -1, 2}},
+	} {
+		if _, err := NewPermutation(layouts); err == nil {
+			t.Fatalf("%v: expected error", layouts)
+		}
+	}
+}
@@ -0,0 +1,390 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// FitsUint8 returns true if v is within [0, MaxUint8].
+func FitsUint8(v uint64) bool {
+	return v <= uint64(MaxUint8)
+}
+
+// FitsInt8 returns true if v is within [MinInt8, MaxInt8].
+func FitsInt8(v int64) bool {
+	return v >= int64(MinInt8) && v <= int64(MaxInt8)
+}
+
+// NarrowUint8 converts v to uint8 and returns false instead, if v does not fit into 8 bit.
+func NarrowUint8(v uint64) (uint8, bool) {
+	if !FitsUint8(v) {
+		return 0, false
+	}
+
+	return uint8(v), true
+}
+
+// NarrowInt8 converts v to int8 and returns false instead, if v does not fit into 8 bit.
+func NarrowInt8(v int64) (int8, bool) {
+	if !FitsInt8(v) {
+		return 0, false
+	}
+
+	return int8(v), true
+}
+
+// SaturateUint8 converts v to uint8 and clamps it to MaxUint8, if v does not fit into 8 bit.
+func SaturateUint8(v uint64) uint8 {
+	if !FitsUint8(v) {
+		return MaxUint8
+	}
+
+	return uint8(v)
+}
+
+// SaturateInt8 converts v to int8 and clamps it to MinInt8 or MaxInt8, if v does not fit into 8 bit.
+func SaturateInt8(v int64) int8 {
+	switch {
+	case v < int64(MinInt8):
+		return MinInt8
+	case v > int64(MaxInt8):
+		return MaxInt8
+	default:
+		return int8(v)
+	}
+}
+
+// FitsUint16 returns true if v is within [0, MaxUint16].
+func FitsUint16(v uint64) bool {
+	return v <= uint64(MaxUint16)
+}
+
+// FitsInt16 returns true if v is within [MinInt16, MaxInt16].
+func FitsInt16(v int64) bool {
+	return v >= int64(MinInt16) && v <= int64(MaxInt16)
+}
+
+// NarrowUint16 converts v to uint16 and returns false instead, if v does not fit into 16 bit.
+func NarrowUint16(v uint64) (uint16, bool) {
+	if !FitsUint16(v) {
+		return 0, false
+	}
+
+	return uint16(v), true
+}
+
+// NarrowInt16 converts v to int16 and returns false instead, if v does not fit into 16 bit.
+func NarrowInt16(v int64) (int16, bool) {
+	if !FitsInt16(v) {
+		return 0, false
+	}
+
+	return int16(v), true
+}
+
+// SaturateUint16 converts v to uint16 and clamps it to MaxUint16, if v does not fit into 16 bit.
+func SaturateUint16(v uint64) uint16 {
+	if !FitsUint16(v) {
+		return MaxUint16
+	}
+
+	return uint16(v)
+}
+
+// SaturateInt16 converts v to int16 and clamps it to MinInt16 or MaxInt16, if v does not fit into 16 bit.
+func SaturateInt16(v int64) int16 {
+	switch {
+	case v < int64(MinInt16):
+		return MinInt16
+	case v > int64(MaxInt16):
+		return MaxInt16
+	default:
+		return int16(v)
+	}
+}
+
+// FitsUint24 returns true if v is within [0, MaxUint24].
+func FitsUint24(v uint64) bool {
+	return v <= uint64(MaxUint24)
+}
+
+// FitsInt24 returns true if v is within [MinInt24, MaxInt24].
+func FitsInt24(v int64) bool {
+	return v >= int64(MinInt24) && v <= int64(MaxInt24)
+}
+
+// NarrowUint24 converts v to uint32 and returns false instead, if v does not fit into 24 bit.
+func NarrowUint24(v uint64) (uint32, bool) {
+	if !FitsUint24(v) {
+		return 0, false
+	}
+
+	return uint32(v), true
+}
+
+// NarrowInt24 converts v to int32 and returns false instead, if v does not fit into 24 bit.
+func NarrowInt24(v int64) (int32, bool) {
+	if !FitsInt24(v) {
+		return 0, false
+	}
+
+	return int32(v), true
+}
+
+// SaturateUint24 converts v to uint32 and clamps it to MaxUint24, if v does not fit into 24 bit.
+func SaturateUint24(v uint64) uint32 {
+	if !FitsUint24(v) {
+		return MaxUint24
+	}
+
+	return uint32(v)
+}
+
+// SaturateInt24 converts v to int32 and clamps it to MinInt24 or MaxInt24, if v does not fit into 24 bit.
+func SaturateInt24(v int64) int32 {
+	switch {
+	case v < int64(MinInt24):
+		return MinInt24
+	case v > int64(MaxInt24):
+		return MaxInt24
+	default:
+		return int32(v)
+	}
+}
+
+// FitsUint32 returns true if v is within [0, MaxUint32].
+func FitsUint32(v uint64) bool {
+	return v <= uint64(MaxUint32)
+}
+
+// FitsInt32 returns true if v is within [MinInt32, MaxInt32].
+func FitsInt32(v int64) bool {
+	return v >= int64(MinInt32) && v <= int64(MaxInt32)
+}
+
+// NarrowUint32 converts v to uint32 and returns false instead, if v does not fit into 32 bit.
+func NarrowUint32(v uint64) (uint32, bool) {
+	if !FitsUint32(v) {
+		return 0, false
+	}
+
+	return uint32(v), true
+}
+
+// NarrowInt32 converts v to int32 and returns false instead, if v does not fit into 32 bit.
+func NarrowInt32(v int64) (int32, bool) {
+	if !FitsInt32(v) {
+		return 0, false
+	}
+
+	return int32(v), true
+}
+
+// SaturateUint32 converts v to uint32 and clamps it to MaxUint32, if v does not fit into 32 bit.
+func SaturateUint32(v uint64) uint32 {
+	if !FitsUint32(v) {
+		return MaxUint32
+	}
+
+	return uint32(v)
+}
+
+// SaturateInt32 converts v to int32 and clamps it to MinInt32 or MaxInt32, if v does not fit into 32 bit.
+func SaturateInt32(v int64) int32 {
+	switch {
+	case v < int64(MinInt32):
+		return MinInt32
+	case v > int64(MaxInt32):
+		return MaxInt32
+	default:
+		return int32(v)
+	}
+}
+
+// FitsUint40 returns true if v is within [0, MaxUint40].
+func FitsUint40(v uint64) bool {
+	return v <= MaxUint40
+}
+
+// FitsInt40 returns true if v is within [MinInt40, MaxInt40].
+func FitsInt40(v int64) bool {
+	return v >= MinInt40 && v <= MaxInt40
+}
+
+// NarrowUint40 converts v to uint64 and returns false instead, if v does not fit into 40 bit.
+func NarrowUint40(v uint64) (uint64, bool) {
+	if !FitsUint40(v) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// NarrowInt40 converts v to int64 and returns false instead, if v does not fit into 40 bit.
+func NarrowInt40(v int64) (int64, bool) {
+	if !FitsInt40(v) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// SaturateUint40 converts v to uint64 and clamps it to MaxUint40, if v does not fit into 40 bit.
+func SaturateUint40(v uint64) uint64 {
+	if !FitsUint40(v) {
+		return MaxUint40
+	}
+
+	return v
+}
+
+// SaturateInt40 converts v to int64 and clamps it to MinInt40 or MaxInt40, if v does not fit into 40 bit.
+func SaturateInt40(v int64) int64 {
+	switch {
+	case v < MinInt40:
+		return MinInt40
+	case v > MaxInt40:
+		return MaxInt40
+	default:
+		return v
+	}
+}
+
+// FitsUint48 returns true if v is within [0, MaxUint48].
+func FitsUint48(v uint64) bool {
+	return v <= MaxUint48
+}
+
+// FitsInt48 returns true if v is within [MinInt48, MaxInt48].
+func FitsInt48(v int64) bool {
+	return v >= MinInt48 && v <= MaxInt48
+}
+
+// NarrowUint48 converts v to uint64 and returns false instead, if v does not fit into 48 bit.
+func NarrowUint48(v uint64) (uint64, bool) {
+	if !FitsUint48(v) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// NarrowInt48 converts v to int64 and returns false instead, if v does not fit into 48 bit.
+func NarrowInt48(v int64) (int64, bool) {
+	if !FitsInt48(v) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// SaturateUint48 converts v to uint64 and clamps it to MaxUint48, if v does not fit into 48 bit.
+func SaturateUint48(v uint64) uint64 {
+	if !FitsUint48(v) {
+		return MaxUint48
+	}
+
+	return v
+}
+
+// SaturateInt48 converts v to int64 and clamps it to MinInt48 or MaxInt48, if v does not fit into 48 bit.
+func SaturateInt48(v int64) int64 {
+	switch {
+	case v < MinInt48:
+		return MinInt48
+	case v > MaxInt48:
+		return MaxInt48
+	default:
+		return v
+	}
+}
+
+// FitsUint56 returns true if v is within [0, MaxUint56].
+func FitsUint56(v uint64) bool {
+	return v <= MaxUint56
+}
+
+// FitsInt56 returns true if v is within [MinInt56, MaxInt56].
+func FitsInt56(v int64) bool {
+	return v >= MinInt56 && v <= MaxInt56
+}
+
+// NarrowUint56 converts v to uint64 and returns false instead, if v does not fit into 56 bit.
+func NarrowUint56(v uint64) (uint64, bool) {
+	if !FitsUint56(v) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// NarrowInt56 converts v to int64 and returns false instead, if v does not fit into 56 bit.
+func NarrowInt56(v int64) (int64, bool) {
+	if !FitsInt56(v) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// SaturateUint56 converts v to uint64 and clamps it to MaxUint56, if v does not fit into 56 bit.
+func SaturateUint56(v uint64) uint64 {
+	if !FitsUint56(v) {
+		return MaxUint56
+	}
+
+	return v
+}
+
+// SaturateInt56 converts v to int64 and clamps it to MinInt56 or MaxInt56, if v does not fit into 56 bit.
+func SaturateInt56(v int64) int64 {
+	switch {
+	case v < MinInt56:
+		return MinInt56
+	case v > MaxInt56:
+		return MaxInt56
+	default:
+		return v
+	}
+}
+
+// FitsUint64 always returns true and only exists for completeness.
+func FitsUint64(_ uint64) bool {
+	return true
+}
+
+// FitsInt64 always returns true and only exists for completeness.
+func FitsInt64(_ int64) bool {
+	return true
+}
+
+// NarrowUint64 returns v unchanged and true and only exists for completeness.
+func NarrowUint64(v uint64) (uint64, bool) {
+	return v, true
+}
+
+// NarrowInt64 returns v unchanged and true and only exists for completeness.
+func NarrowInt64(v int64) (int64, bool) {
+	return v, true
+}
+
+// SaturateUint64 returns v unchanged and only exists for completeness.
+func SaturateUint64(v uint64) uint64 {
+	return v
+}
+
+// SaturateInt64 returns v unchanged and only exists for completeness.
+func SaturateInt64(v int64) int64 {
+	return v
+}
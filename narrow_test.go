@@ -0,0 +1,110 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+//nolint:funlen
+func TestNarrow(t *testing.T) {
+	tests := []struct {
+		bits     uint
+		fitsU    func(uint64) bool
+		fitsI    func(int64) bool
+		narrowU  func(uint64) (uint64, bool)
+		narrowI  func(int64) (int64, bool)
+		saturate func(uint64) uint64
+		clamp    func(int64) int64
+	}{
+		{
+			8, FitsUint8, FitsInt8,
+			func(v uint64) (uint64, bool) { r, ok := NarrowUint8(v); return uint64(r), ok },
+			func(v int64) (int64, bool) { r, ok := NarrowInt8(v); return int64(r), ok },
+			func(v uint64) uint64 { return uint64(SaturateUint8(v)) },
+			func(v int64) int64 { return int64(SaturateInt8(v)) },
+		},
+		{
+			16, FitsUint16, FitsInt16,
+			func(v uint64) (uint64, bool) { r, ok := NarrowUint16(v); return uint64(r), ok },
+			func(v int64) (int64, bool) { r, ok := NarrowInt16(v); return int64(r), ok },
+			func(v uint64) uint64 { return uint64(SaturateUint16(v)) },
+			func(v int64) int64 { return int64(SaturateInt16(v)) },
+		},
+		{
+			24, FitsUint24, FitsInt24,
+			func(v uint64) (uint64, bool) { r, ok := NarrowUint24(v); return uint64(r), ok },
+			func(v int64) (int64, bool) { r, ok := NarrowInt24(v); return int64(r), ok },
+			func(v uint64) uint64 { return uint64(SaturateUint24(v)) },
+			func(v int64) int64 { return int64(SaturateInt24(v)) },
+		},
+		{
+			32, FitsUint32, FitsInt32,
+			func(v uint64) (uint64, bool) { r, ok := NarrowUint32(v); return uint64(r), ok },
+			func(v int64) (int64, bool) { r, ok := NarrowInt32(v); return int64(r), ok },
+			func(v uint64) uint64 { return uint64(SaturateUint32(v)) },
+			func(v int64) int64 { return int64(SaturateInt32(v)) },
+		},
+		{40, FitsUint40, FitsInt40, NarrowUint40, NarrowInt40, SaturateUint40, SaturateInt40},
+		{48, FitsUint48, FitsInt48, NarrowUint48, NarrowInt48, SaturateUint48, SaturateInt48},
+		{56, FitsUint56, FitsInt56, NarrowUint56, NarrowInt56, SaturateUint56, SaturateInt56},
+		{64, FitsUint64, FitsInt64, NarrowUint64, NarrowInt64, SaturateUint64, SaturateInt64},
+	}
+
+	for _, tt := range tests {
+		max := uint64(math.MaxUint64) >> (64 - tt.bits)
+		maxInt := int64(max >> 1)
+		minInt := ^maxInt
+
+		for _, v := range []uint64{0, 1, max >> 1, max - 1, max} {
+			if r, ok := tt.narrowU(v); !tt.fitsU(v) || !ok || r != v || tt.saturate(v) != v {
+				t.Fatalf("%d: unexpected result for %d", tt.bits, v)
+			}
+		}
+
+		for _, v := range []int64{minInt, minInt + 1, -1, 0, 1, maxInt - 1, maxInt} {
+			if r, ok := tt.narrowI(v); !tt.fitsI(v) || !ok || r != v || tt.clamp(v) != v {
+				t.Fatalf("%d: unexpected result for %d", tt.bits, v)
+			}
+		}
+
+		if tt.bits == 64 {
+			continue
+		}
+
+		for _, v := range []uint64{max + 1, max << 1, math.MaxUint64} {
+			if r, ok := tt.narrowU(v); tt.fitsU(v) || ok || r != 0 || tt.saturate(v) != max {
+				t.Fatalf("%d: expected %d to not fit", tt.bits, v)
+			}
+		}
+
+		for _, v := range []int64{maxInt + 1, math.MaxInt64} {
+			if r, ok := tt.narrowI(v); tt.fitsI(v) || ok || r != 0 || tt.clamp(v) != maxInt {
+				t.Fatalf("%d: expected %d to not fit", tt.bits, v)
+			}
+		}
+
+		for _, v := range []int64{minInt - 1, math.MinInt64} {
+			if r, ok := tt.narrowI(v); tt.fitsI(v) || ok || r != 0 || tt.clamp(v) != minInt {
+				t.Fatalf("%d: expected %d to not fit", tt.bits, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+//go:build 386 || amd64 || amd64p32 || alpha || arm || arm64 || loong64 || mips64le || mips64p32le || mipsle || nios2 || ppc64le || riscv || riscv64 || sh || wasm
+// +build 386 amd64 amd64p32 alpha arm arm64 loong64 mips64le mips64p32le mipsle nios2 ppc64le riscv riscv64 sh wasm
+
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// IsLittleEndian reports whether the host uses a little-endian memory layout, which is true for this GOARCH.
+const IsLittleEndian = true
+
+// Native is the byte order of the host.
+const Native = Little
+
+// NativeEndian defines the serialization in the memory layout of the host, which is little-endian for this GOARCH.
+type NativeEndian = LittleEndian
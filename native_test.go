@@ -0,0 +1,61 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"testing"
+	"unsafe"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestIsLittleEndian(t *testing.T) {
+	v := uint16(1)
+	if first := *(*byte)(unsafe.Pointer(&v)); IsLittleEndian != (first == 1) {
+		t.Fatalf("IsLittleEndian is %v but the first byte is %d", IsLittleEndian, first)
+	}
+
+	if (Native == Little) != IsLittleEndian {
+		t.Fatalf("Native is %v", Native)
+	}
+}
+
+func TestNativeEndian(t *testing.T) {
+	var word uint64 // guarantees the alignment for all unsafe reads below
+
+	p := unsafe.Pointer(&word)
+	buf := (*[8]byte)(p)
+	copy(buf[:], src)
+	b := NativeEndian(buf[:])
+
+	if b.ReadUint16() != *(*uint16)(p) || b.ReadUint32() != *(*uint32)(p) || b.ReadUint64() != *(*uint64)(p) {
+		t.Fatalf("NativeEndian differs from the memory layout")
+	}
+
+	if b.ReadInt64() != *(*int64)(p) || b.ReadFloat64() != *(*float64)(p) {
+		t.Fatalf("NativeEndian differs from the memory layout")
+	}
+
+	if Native.ReadUint32(buf[:]) != *(*uint32)(p) {
+		t.Fatalf("Native differs from the memory layout")
+	}
+
+	*(*uint64)(p) = 0x0102030405060708
+	if b.ReadUint64() != 0x0102030405060708 {
+		t.Fatalf("NativeEndian differs from the memory layout")
+	}
+}
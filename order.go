@@ -0,0 +1,522 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import "strconv"
+
+var _ AppendByteOrder = Little
+
+// An Order is a byte order value which can be selected at runtime, e.g. after inspecting the "II" or "MM" magic of
+// a TIFF file. In contrast to LittleEndian and BigEndian, its methods take the slice as a parameter, so a single
+// code path can decode either order. Any value other than Big is treated as Little.
+type Order uint8
+
+const (
+	// Little denotes the little-endian order. It is the zero value of Order.
+	Little Order = iota
+	// Big denotes the big-endian order.
+	Big
+)
+
+// String returns LittleEndian or BigEndian.
+func (o Order) String() string {
+	switch o {
+	case Little:
+		return "LittleEndian"
+	case Big:
+		return "BigEndian"
+	default:
+		return "Order(" + strconv.Itoa(int(o)) + ")"
+	}
+}
+
+// View returns either a LittleEndian or a BigEndian view of b.
+func (o Order) View(b []byte) ByteOrder {
+	if o == Big {
+		return BigEndian(b)
+	}
+
+	return LittleEndian(b)
+}
+
+// ReadUint16 reads the first 2 bytes of b. Panics when len(b) < 2.
+func (o Order) ReadUint16(b []byte) uint16 {
+	if o == Big {
+		return BigEndian(b).ReadUint16()
+	}
+
+	return LittleEndian(b).ReadUint16()
+}
+
+// WriteUint16 writes the first 2 bytes of b. Panics when len(b) < 2.
+func (o Order) WriteUint16(b []byte, v uint16) {
+	if o == Big {
+		BigEndian(b).WriteUint16(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint16(v)
+}
+
+// AppendUint16 appends 2 bytes to dst and returns the extended slice.
+func (o Order) AppendUint16(dst []byte, v uint16) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint16(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint16(dst, v)
+}
+
+// ReadUint24 reads the first 3 bytes of b. Panics when len(b) < 3.
+func (o Order) ReadUint24(b []byte) uint32 {
+	if o == Big {
+		return BigEndian(b).ReadUint24()
+	}
+
+	return LittleEndian(b).ReadUint24()
+}
+
+// WriteUint24 writes the first 3 bytes of b. Panics when len(b) < 3.
+func (o Order) WriteUint24(b []byte, v uint32) {
+	if o == Big {
+		BigEndian(b).WriteUint24(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint24(v)
+}
+
+// AppendUint24 appends 3 bytes to dst and returns the extended slice.
+func (o Order) AppendUint24(dst []byte, v uint32) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint24(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint24(dst, v)
+}
+
+// ReadUint32 reads the first 4 bytes of b. Panics when len(b) < 4.
+func (o Order) ReadUint32(b []byte) uint32 {
+	if o == Big {
+		return BigEndian(b).ReadUint32()
+	}
+
+	return LittleEndian(b).ReadUint32()
+}
+
+// WriteUint32 writes the first 4 bytes of b. Panics when len(b) < 4.
+func (o Order) WriteUint32(b []byte, v uint32) {
+	if o == Big {
+		BigEndian(b).WriteUint32(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint32(v)
+}
+
+// AppendUint32 appends 4 bytes to dst and returns the extended slice.
+func (o Order) AppendUint32(dst []byte, v uint32) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint32(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint32(dst, v)
+}
+
+// ReadUint40 reads the first 5 bytes of b. Panics when len(b) < 5.
+func (o Order) ReadUint40(b []byte) uint64 {
+	if o == Big {
+		return BigEndian(b).ReadUint40()
+	}
+
+	return LittleEndian(b).ReadUint40()
+}
+
+// WriteUint40 writes the first 5 bytes of b. Panics when len(b) < 5.
+func (o Order) WriteUint40(b []byte, v uint64) {
+	if o == Big {
+		BigEndian(b).WriteUint40(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint40(v)
+}
+
+// AppendUint40 appends 5 bytes to dst and returns the extended slice.
+func (o Order) AppendUint40(dst []byte, v uint64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint40(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint40(dst, v)
+}
+
+// ReadUint48 reads the first 6 bytes of b. Panics when len(b) < 6.
+func (o Order) ReadUint48(b []byte) uint64 {
+	if o == Big {
+		return BigEndian(b).ReadUint48()
+	}
+
+	return LittleEndian(b).ReadUint48()
+}
+
+// WriteUint48 writes the first 6 bytes of b. Panics when len(b) < 6.
+func (o Order) WriteUint48(b []byte, v uint64) {
+	if o == Big {
+		BigEndian(b).WriteUint48(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint48(v)
+}
+
+// AppendUint48 appends 6 bytes to dst and returns the extended slice.
+func (o Order) AppendUint48(dst []byte, v uint64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint48(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint48(dst, v)
+}
+
+// ReadUint56 reads the first 7 bytes of b. Panics when len(b) < 7.
+func (o Order) ReadUint56(b []byte) uint64 {
+	if o == Big {
+		return BigEndian(b).ReadUint56()
+	}
+
+	return LittleEndian(b).ReadUint56()
+}
+
+// WriteUint56 writes the first 7 bytes of b. Panics when len(b) < 7.
+func (o Order) WriteUint56(b []byte, v uint64) {
+	if o == Big {
+		BigEndian(b).WriteUint56(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint56(v)
+}
+
+// AppendUint56 appends 7 bytes to dst and returns the extended slice.
+func (o Order) AppendUint56(dst []byte, v uint64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint56(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint56(dst, v)
+}
+
+// ReadUint64 reads the first 8 bytes of b. Panics when len(b) < 8.
+func (o Order) ReadUint64(b []byte) uint64 {
+	if o == Big {
+		return BigEndian(b).ReadUint64()
+	}
+
+	return LittleEndian(b).ReadUint64()
+}
+
+// WriteUint64 writes the first 8 bytes of b. Panics when len(b) < 8.
+func (o Order) WriteUint64(b []byte, v uint64) {
+	if o == Big {
+		BigEndian(b).WriteUint64(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint64(v)
+}
+
+// AppendUint64 appends 8 bytes to dst and returns the extended slice.
+func (o Order) AppendUint64(dst []byte, v uint64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendUint64(dst, v)
+	}
+
+	return LittleEndian(nil).AppendUint64(dst, v)
+}
+
+// ReadInt16 reads the first 2 bytes of b. Panics when len(b) < 2.
+func (o Order) ReadInt16(b []byte) int16 {
+	if o == Big {
+		return BigEndian(b).ReadInt16()
+	}
+
+	return LittleEndian(b).ReadInt16()
+}
+
+// WriteInt16 writes the first 2 bytes of b. Panics when len(b) < 2.
+func (o Order) WriteInt16(b []byte, v int16) {
+	if o == Big {
+		BigEndian(b).WriteInt16(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt16(v)
+}
+
+// AppendInt16 appends 2 bytes to dst and returns the extended slice.
+func (o Order) AppendInt16(dst []byte, v int16) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt16(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt16(dst, v)
+}
+
+// ReadInt24 reads the first 3 bytes of b. Panics when len(b) < 3.
+func (o Order) ReadInt24(b []byte) int32 {
+	if o == Big {
+		return BigEndian(b).ReadInt24()
+	}
+
+	return LittleEndian(b).ReadInt24()
+}
+
+// WriteInt24 writes the first 3 bytes of b. Panics when len(b) < 3 or if v is not within [MinInt24, MaxInt24].
+func (o Order) WriteInt24(b []byte, v int32) {
+	if o == Big {
+		BigEndian(b).WriteInt24(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt24(v)
+}
+
+// AppendInt24 appends 3 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt24, MaxInt24].
+func (o Order) AppendInt24(dst []byte, v int32) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt24(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt24(dst, v)
+}
+
+// ReadInt32 reads the first 4 bytes of b. Panics when len(b) < 4.
+func (o Order) ReadInt32(b []byte) int32 {
+	if o == Big {
+		return BigEndian(b).ReadInt32()
+	}
+
+	return LittleEndian(b).ReadInt32()
+}
+
+// WriteInt32 writes the first 4 bytes of b. Panics when len(b) < 4.
+func (o Order) WriteInt32(b []byte, v int32) {
+	if o == Big {
+		BigEndian(b).WriteInt32(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt32(v)
+}
+
+// AppendInt32 appends 4 bytes to dst and returns the extended slice.
+func (o Order) AppendInt32(dst []byte, v int32) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt32(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt32(dst, v)
+}
+
+// ReadInt40 reads the first 5 bytes of b. Panics when len(b) < 5.
+func (o Order) ReadInt40(b []byte) int64 {
+	if o == Big {
+		return BigEndian(b).ReadInt40()
+	}
+
+	return LittleEndian(b).ReadInt40()
+}
+
+// WriteInt40 writes the first 5 bytes of b. Panics when len(b) < 5 or if v is not within [MinInt40, MaxInt40].
+func (o Order) WriteInt40(b []byte, v int64) {
+	if o == Big {
+		BigEndian(b).WriteInt40(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt40(v)
+}
+
+// AppendInt40 appends 5 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt40, MaxInt40].
+func (o Order) AppendInt40(dst []byte, v int64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt40(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt40(dst, v)
+}
+
+// ReadInt48 reads the first 6 bytes of b. Panics when len(b) < 6.
+func (o Order) ReadInt48(b []byte) int64 {
+	if o == Big {
+		return BigEndian(b).ReadInt48()
+	}
+
+	return LittleEndian(b).ReadInt48()
+}
+
+// WriteInt48 writes the first 6 bytes of b. Panics when len(b) < 6 or if v is not within [MinInt48, MaxInt48].
+func (o Order) WriteInt48(b []byte, v int64) {
+	if o == Big {
+		BigEndian(b).WriteInt48(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt48(v)
+}
+
+// AppendInt48 appends 6 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt48, MaxInt48].
+func (o Order) AppendInt48(dst []byte, v int64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt48(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt48(dst, v)
+}
+
+// ReadInt56 reads the first 7 bytes of b. Panics when len(b) < 7.
+func (o Order) ReadInt56(b []byte) int64 {
+	if o == Big {
+		return BigEndian(b).ReadInt56()
+	}
+
+	return LittleEndian(b).ReadInt56()
+}
+
+// WriteInt56 writes the first 7 bytes of b. Panics when len(b) < 7 or if v is not within [MinInt56, MaxInt56].
+func (o Order) WriteInt56(b []byte, v int64) {
+	if o == Big {
+		BigEndian(b).WriteInt56(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt56(v)
+}
+
+// AppendInt56 appends 7 bytes to dst and returns the extended slice. Panics if v is not within
+// [MinInt56, MaxInt56].
+func (o Order) AppendInt56(dst []byte, v int64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt56(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt56(dst, v)
+}
+
+// ReadInt64 reads the first 8 bytes of b. Panics when len(b) < 8.
+func (o Order) ReadInt64(b []byte) int64 {
+	if o == Big {
+		return BigEndian(b).ReadInt64()
+	}
+
+	return LittleEndian(b).ReadInt64()
+}
+
+// WriteInt64 writes the first 8 bytes of b. Panics when len(b) < 8.
+func (o Order) WriteInt64(b []byte, v int64) {
+	if o == Big {
+		BigEndian(b).WriteInt64(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt64(v)
+}
+
+// AppendInt64 appends 8 bytes to dst and returns the extended slice.
+func (o Order) AppendInt64(dst []byte, v int64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendInt64(dst, v)
+	}
+
+	return LittleEndian(nil).AppendInt64(dst, v)
+}
+
+// ReadFloat32 reads the first 4 bytes of b. Panics when len(b) < 4.
+func (o Order) ReadFloat32(b []byte) float32 {
+	if o == Big {
+		return BigEndian(b).ReadFloat32()
+	}
+
+	return LittleEndian(b).ReadFloat32()
+}
+
+// WriteFloat32 writes the first 4 bytes of b. Panics when len(b) < 4.
+func (o Order) WriteFloat32(b []byte, v float32) {
+	if o == Big {
+		BigEndian(b).WriteFloat32(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteFloat32(v)
+}
+
+// AppendFloat32 appends 4 bytes to dst and returns the extended slice.
+func (o Order) AppendFloat32(dst []byte, v float32) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendFloat32(dst, v)
+	}
+
+	return LittleEndian(nil).AppendFloat32(dst, v)
+}
+
+// ReadFloat64 reads the first 8 bytes of b. Panics when len(b) < 8.
+func (o Order) ReadFloat64(b []byte) float64 {
+	if o == Big {
+		return BigEndian(b).ReadFloat64()
+	}
+
+	return LittleEndian(b).ReadFloat64()
+}
+
+// WriteFloat64 writes the first 8 bytes of b. Panics when len(b) < 8.
+func (o Order) WriteFloat64(b []byte, v float64) {
+	if o == Big {
+		BigEndian(b).WriteFloat64(v)
+
+		return
+	}
+
+	LittleEndian(b).WriteFloat64(v)
+}
+
+// AppendFloat64 appends 8 bytes to dst and returns the extended slice.
+func (o Order) AppendFloat64(dst []byte, v float64) []byte {
+	if o == Big {
+		return BigEndian(nil).AppendFloat64(dst, v)
+	}
+
+	return LittleEndian(nil).AppendFloat64(dst, v)
+}
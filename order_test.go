@@ -0,0 +1,180 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestOrderString(t *testing.T) {
+	if Little.String() != "LittleEndian" || Big.String() != "BigEndian" || Order(7).String() != "Order(7)" {
+		t.Fatalf("unexpected order names")
+	}
+}
+
+func TestOrder(t *testing.T) {
+	for _, order := range []Order{Little, Big} {
+		view := order.View(src)
+
+		for _, tt := range []struct {
+			size      int
+			copyValue func(o Order, view ByteOrder, dst []byte) (equal bool, appended []byte)
+		}{
+			{2, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint16(src)
+				o.WriteUint16(dst, v)
+
+				return v == view.ReadUint16(), o.AppendUint16(nil, v)
+			}},
+			{3, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint24(src)
+				o.WriteUint24(dst, v)
+
+				return v == view.ReadUint24(), o.AppendUint24(nil, v)
+			}},
+			{4, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint32(src)
+				o.WriteUint32(dst, v)
+
+				return v == view.ReadUint32(), o.AppendUint32(nil, v)
+			}},
+			{5, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint40(src)
+				o.WriteUint40(dst, v)
+
+				return v == view.ReadUint40(), o.AppendUint40(nil, v)
+			}},
+			{6, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint48(src)
+				o.WriteUint48(dst, v)
+
+				return v == view.ReadUint48(), o.AppendUint48(nil, v)
+			}},
+			{7, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint56(src)
+				o.WriteUint56(dst, v)
+
+				return v == view.ReadUint56(), o.AppendUint56(nil, v)
+			}},
+			{8, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadUint64(src)
+				o.WriteUint64(dst, v)
+
+				return v == view.ReadUint64(), o.AppendUint64(nil, v)
+			}},
+			{2, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt16(src)
+				o.WriteInt16(dst, v)
+
+				return v == view.ReadInt16(), o.AppendInt16(nil, v)
+			}},
+			{3, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt24(src)
+				o.WriteInt24(dst, v)
+
+				return v == view.ReadInt24(), o.AppendInt24(nil, v)
+			}},
+			{4, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt32(src)
+				o.WriteInt32(dst, v)
+
+				return v == view.ReadInt32(), o.AppendInt32(nil, v)
+			}},
+			{5, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt40(src)
+				o.WriteInt40(dst, v)
+
+				return v == view.ReadInt40(), o.AppendInt40(nil, v)
+			}},
+			{6, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt48(src)
+				o.WriteInt48(dst, v)
+
+				return v == view.ReadInt48(), o.AppendInt48(nil, v)
+			}},
+			{7, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt56(src)
+				o.WriteInt56(dst, v)
+
+				return v == view.ReadInt56(), o.AppendInt56(nil, v)
+			}},
+			{8, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadInt64(src)
+				o.WriteInt64(dst, v)
+
+				return v == view.ReadInt64(), o.AppendInt64(nil, v)
+			}},
+			{4, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadFloat32(src)
+				o.WriteFloat32(dst, v)
+
+				return v == view.ReadFloat32(), o.AppendFloat32(nil, v)
+			}},
+			{8, func(o Order, view ByteOrder, dst []byte) (bool, []byte) {
+				v := o.ReadFloat64(src)
+				o.WriteFloat64(dst, v)
+
+				return v == view.ReadFloat64(), o.AppendFloat64(nil, v)
+			}},
+		} {
+			tmp := make([]byte, tt.size)
+			equal, appended := tt.copyValue(order, view, tmp)
+
+			if !equal {
+				t.Fatalf("%v %d: read differs from the view", order, tt.size)
+			}
+
+			assertValues(t, tmp)
+
+			if !bytes.Equal(appended, tmp) {
+				t.Fatalf("%v %d: unexpected append %X", order, tt.size, appended)
+			}
+		}
+	}
+}
+
+func TestOrderTIFF(t *testing.T) {
+	for _, header := range [][]byte{
+		{'I', 'I', 42, 0, 8, 0, 0, 0},
+		{'M', 'M', 0, 42, 0, 0, 0, 8},
+	} {
+		order := Little
+		if header[0] == 'M' {
+			order = Big
+		}
+
+		r := NewReader(header, order)
+		r.Skip(2)
+
+		if r.Order() != order || r.ReadUint16() != 42 || r.ReadUint32() != 8 {
+			t.Fatalf("unexpected %v header", order)
+		}
+
+		buf := NewBuffer(nil, order)
+		buf.Grow(len(header))
+		buf.AppendUint16(uint16(header[0])<<8 | uint16(header[1]))
+		buf.AppendUint16(42)
+		buf.AppendUint32(8)
+
+		if buf.Order() != order || !bytes.Equal(buf.Bytes(), header) {
+			t.Fatalf("unexpected %v encoding %v", order, buf.Bytes())
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	errInvalidWhence  = errors.New("byteorder.Reader.Seek: invalid whence")
+	errInvalidSeekPos = errors.New("byteorder.Reader.Seek: position out of range")
+)
+
+// A Reader decodes values from a byte slice in either little-endian or big-endian order and advances its position
+// after each read, so that consecutive fields can be decoded without any offset arithmetic. Just like
+// LittleEndian and BigEndian, all Read methods panic when not enough bytes remain.
+type Reader struct {
+	buf   []byte
+	pos   int
+	order Order
+}
+
+// NewReader creates a Reader which decodes b in the given order.
+func NewReader(b []byte, order Order) *Reader {
+	return &Reader{buf: b[:len(b):len(b)], order: order}
+}
+
+// NewLittleEndianReader creates a Reader which decodes b in little-endian order.
+func NewLittleEndianReader(b []byte) *Reader {
+	return NewReader(b, Little)
+}
+
+// NewBigEndianReader creates a Reader which decodes b in big-endian order.
+func NewBigEndianReader(b []byte) *Reader {
+	return NewReader(b, Big)
+}
+
+// Order returns the byte order used for decoding.
+func (r *Reader) Order() Order {
+	return r.order
+}
+
+// Pos returns the current offset from the start of the underlying slice.
+func (r *Reader) Pos() int {
+	return r.pos
+}
+
+// Remaining returns the amount of unread bytes.
+func (r *Reader) Remaining() int {
+	return len(r.buf) - r.pos
+}
+
+// Skip advances the position by n bytes. Panics when n < 0 or n > Remaining().
+func (r *Reader) Skip(n int) {
+	r.Next(n)
+}
+
+// Next returns a slice of the next n bytes and advances the position. The returned slice shares the
+// underlying memory. Panics when n < 0 or n > Remaining().
+func (r *Reader) Next(n int) []byte {
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+
+	return b
+}
+
+// Seek implements io.Seeker and sets the position for the next read. Seeking before the start or beyond the
+// end of the slice is an error.
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = int64(r.pos) + offset
+	case io.SeekEnd:
+		abs = int64(len(r.buf)) + offset
+	default:
+		return 0, errInvalidWhence
+	}
+
+	if abs < 0 || abs > int64(len(r.buf)) {
+		return 0, errInvalidSeekPos
+	}
+
+	r.pos = int(abs)
+
+	return abs, nil
+}
+
+// ReadUint16 reads the next 2 bytes and advances the position. Panics when Remaining() < 2.
+func (r *Reader) ReadUint16() uint16 {
+	return r.order.ReadUint16(r.Next(2)) //nolint:gomnd
+}
+
+// ReadUint24 reads the next 3 bytes and advances the position. Panics when Remaining() < 3.
+func (r *Reader) ReadUint24() uint32 {
+	return r.order.ReadUint24(r.Next(3)) //nolint:gomnd
+}
+
+// ReadUint32 reads the next 4 bytes and advances the position. Panics when Remaining() < 4.
+func (r *Reader) ReadUint32() uint32 {
+	return r.order.ReadUint32(r.Next(4)) //nolint:gomnd
+}
+
+// ReadUint40 reads the next 5 bytes and advances the position. Panics when Remaining() < 5.
+func (r *Reader) ReadUint40() uint64 {
+	return r.order.ReadUint40(r.Next(5)) //nolint:gomnd
+}
+
+// ReadUint48 reads the next 6 bytes and advances the position. Panics when Remaining() < 6.
+func (r *Reader) ReadUint48() uint64 {
+	return r.order.ReadUint48(r.Next(6)) //nolint:gomnd
+}
+
+// ReadUint56 reads the next 7 bytes and advances the position. Panics when Remaining() < 7.
+func (r *Reader) ReadUint56() uint64 {
+	return r.order.ReadUint56(r.Next(7)) //nolint:gomnd
+}
+
+// ReadUint64 reads the next 8 bytes and advances the position. Panics when Remaining() < 8.
+func (r *Reader) ReadUint64() uint64 {
+	return r.order.ReadUint64(r.Next(8)) //nolint:gomnd
+}
+
+// ReadInt16 reads the next 2 bytes and advances the position. Panics when Remaining() < 2.
+func (r *Reader) ReadInt16() int16 {
+	return r.order.ReadInt16(r.Next(2)) //nolint:gomnd
+}
+
+// ReadInt24 reads the next 3 bytes and advances the position. Panics when Remaining() < 3.
+func (r *Reader) ReadInt24() int32 {
+	return r.order.ReadInt24(r.Next(3)) //nolint:gomnd
+}
+
+// ReadInt32 reads the next 4 bytes and advances the position. Panics when Remaining() < 4.
+func (r *Reader) ReadInt32() int32 {
+	return r.order.ReadInt32(r.Next(4)) //nolint:gomnd
+}
+
+// ReadInt40 reads the next 5 bytes and advances the position. Panics when Remaining() < 5.
+func (r *Reader) ReadInt40() int64 {
+	return r.order.ReadInt40(r.Next(5)) //nolint:gomnd
+}
+
+// ReadInt48 reads the next 6 bytes and advances the position. Panics when Remaining() < 6.
+func (r *Reader) ReadInt48() int64 {
+	return r.order.ReadInt48(r.Next(6)) //nolint:gomnd
+}
+
+// ReadInt56 reads the next 7 bytes and advances the position. Panics when Remaining() < 7.
+func (r *Reader) ReadInt56() int64 {
+	return r.order.ReadInt56(r.Next(7)) //nolint:gomnd
+}
+
+// ReadInt64 reads the next 8 bytes and advances the position. Panics when Remaining() < 8.
+func (r *Reader) ReadInt64() int64 {
+	return r.order.ReadInt64(r.Next(8)) //nolint:gomnd
+}
+
+// ReadFloat32 reads the next 4 bytes and advances the position. Panics when Remaining() < 4.
+func (r *Reader) ReadFloat32() float32 {
+	return r.order.ReadFloat32(r.Next(4)) //nolint:gomnd
+}
+
+// ReadFloat64 reads the next 8 bytes and advances the position. Panics when Remaining() < 8.
+func (r *Reader) ReadFloat64() float64 {
+	return r.order.ReadFloat64(r.Next(8)) //nolint:gomnd
+}
+
+// ReadUvarint decodes an unsigned LEB128 value and advances the position. The position is unchanged in case of
+// an error, see also ReadUvarint.
+func (r *Reader) ReadUvarint() (uint64, error) {
+	v, n, err := ReadUvarint(r.buf[r.pos:])
+	r.pos += n
+
+	return v, err
+}
+
+// ReadVarint decodes a signed LEB128 value and advances the position. The position is unchanged in case of
+// an error, see also ReadVarint.
+func (r *Reader) ReadVarint() (int64, error) {
+	v, n, err := ReadVarint(r.buf[r.pos:])
+	r.pos += n
+
+	return v, err
+}
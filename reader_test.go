@@ -0,0 +1,148 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestReader(t *testing.T) {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, src[:2]...)
+	buf = append(buf, src[:3]...)
+	buf = append(buf, src[:4]...)
+	buf = append(buf, src[:5]...)
+	buf = append(buf, src[:6]...)
+	buf = append(buf, src[:7]...)
+	buf = append(buf, src[:8]...)
+
+	tests := []struct {
+		r     *Reader
+		order func([]byte) ByteOrder
+	}{
+		{NewLittleEndianReader(buf), func(b []byte) ByteOrder { return LE(b) }},
+		{NewBigEndianReader(buf), func(b []byte) ByteOrder { return BE(b) }},
+	}
+
+	for _, tt := range tests {
+		if tt.r.ReadUint16() != tt.order(src).ReadUint16() {
+			t.Fatalf("unexpected uint16")
+		}
+
+		if tt.r.Pos() != 2 || tt.r.Remaining() != len(buf)-2 {
+			t.Fatalf("unexpected position %d", tt.r.Pos())
+		}
+
+		assertReaderUints(t, tt.r, tt.order)
+		assertReaderInts(t, tt.r, tt.order)
+		assertReaderFloats(t, tt.r, tt.order)
+	}
+}
+
+func assertReaderUints(t *testing.T, r *Reader, order func([]byte) ByteOrder) {
+	t.Helper()
+
+	if r.ReadUint24() != order(src).ReadUint24() ||
+		r.ReadUint32() != order(src).ReadUint32() ||
+		r.ReadUint40() != order(src).ReadUint40() ||
+		r.ReadUint48() != order(src).ReadUint48() ||
+		r.ReadUint56() != order(src).ReadUint56() ||
+		r.ReadUint64() != order(src).ReadUint64() {
+		t.Fatalf("unexpected unsigned values")
+	}
+
+	if r.Remaining() != 0 {
+		t.Fatalf("expected end of buffer but %d bytes remain", r.Remaining())
+	}
+}
+
+func assertReaderInts(t *testing.T, r *Reader, order func([]byte) ByteOrder) {
+	t.Helper()
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.ReadInt16() != order(src).ReadInt16() ||
+		r.ReadInt24() != order(src).ReadInt24() ||
+		r.ReadInt32() != order(src).ReadInt32() ||
+		r.ReadInt40() != order(src).ReadInt40() ||
+		r.ReadInt48() != order(src).ReadInt48() ||
+		r.ReadInt56() != order(src).ReadInt56() ||
+		r.ReadInt64() != order(src).ReadInt64() {
+		t.Fatalf("unexpected signed values")
+	}
+}
+
+func assertReaderFloats(t *testing.T, r *Reader, order func([]byte) ByteOrder) {
+	t.Helper()
+
+	if _, err := r.Seek(-8, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.ReadFloat64() != order(src).ReadFloat64() {
+		t.Fatalf("unexpected float64")
+	}
+
+	if _, err := r.Seek(-8, io.SeekCurrent); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.ReadFloat32() != order(src).ReadFloat32() {
+		t.Fatalf("unexpected float32")
+	}
+}
+
+func TestReaderSeek(t *testing.T) {
+	r := NewLittleEndianReader(src[:4])
+	r.Skip(3)
+
+	if r.Pos() != 3 {
+		t.Fatalf("expected position 3 but got %d", r.Pos())
+	}
+
+	if _, err := r.Seek(0, 42); err == nil {
+		t.Fatalf("expected invalid whence")
+	}
+
+	if _, err := r.Seek(-1, io.SeekStart); err == nil {
+		t.Fatalf("expected invalid position")
+	}
+
+	if _, err := r.Seek(1, io.SeekEnd); err == nil {
+		t.Fatalf("expected invalid position")
+	}
+
+	if pos, err := r.Seek(4, io.SeekStart); err != nil || pos != 4 {
+		t.Fatalf("expected position 4 but got %d: %v", pos, err)
+	}
+}
+
+func TestReaderShort(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+
+	// src has a larger capacity, which must not be readable
+	NewBigEndianReader(src[:3]).ReadUint32()
+}
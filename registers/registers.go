@@ -0,0 +1,707 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package registers encodes and decodes values of all widths supported by the byteorder package into arrays of 16
+// bit registers, as exposed by Modbus devices. Values wider than 16 bit span multiple registers, whose order is
+// determined by a WordOrder. Odd widths are right-aligned in the next larger amount of registers, e.g. a 24 bit
+// value occupies 2 registers, whose most significant byte is written as zero and ignored when reading.
+package registers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/worldiety/byteorder"
+)
+
+// ErrShortRange is the sentinel for a RangeError, whose register range is too small, and can be matched using
+// errors.Is.
+var ErrShortRange = errors.New("short register range")
+
+// ErrMisaligned is the sentinel for a RangeError, whose register range is not a multiple of the registers per
+// value, and can be matched using errors.Is.
+var ErrMisaligned = errors.New("misaligned register range")
+
+// RangeError is returned if a register range is too small or not a multiple of the registers per value.
+type RangeError struct {
+	// Needed is the amount of registers required by the operation or per value.
+	Needed int
+
+	// Available is the actual amount of registers.
+	Available int
+
+	// Misaligned is true if Available is not a multiple of Needed, which are the registers per value.
+	Misaligned bool
+}
+
+// Error returns a description including the needed and available amount of registers.
+func (e *RangeError) Error() string {
+	if !e.Misaligned {
+		return fmt.Sprintf("registers: short register range, needed %d registers but only %d available",
+			e.Needed, e.Available)
+	}
+
+	return fmt.Sprintf("registers: misaligned register range of %d registers for values of %d registers",
+		e.Available, e.Needed)
+}
+
+// Is returns true if target is either ErrShortRange or ErrMisaligned, depending on Misaligned.
+func (e *RangeError) Is(target error) bool {
+	if e.Misaligned {
+		return target == ErrMisaligned
+	}
+
+	return target == ErrShortRange
+}
+
+// ErrNotASCII is returned if a string contains a non-ASCII character.
+var ErrNotASCII = errors.New("registers: not an ASCII string")
+
+// WordOrder determines the order of the registers of a value and of the bytes within each register. After
+// serializing the registers big-endian, the bytes of ABCD, CDAB, BADC and DCBA are laid out like byteorder.BE,
+// byteorder.CDAB, byteorder.BADC and byteorder.LE.
+type WordOrder uint8
+
+const (
+	// ABCD stores the most significant register first and each register big-endian, which is the Modbus default.
+	ABCD WordOrder = iota
+	// CDAB stores the least significant register first and each register big-endian, also known as word swapped.
+	CDAB
+	// BADC stores the most significant register first and each register little-endian, also known as byte swapped.
+	BADC
+	// DCBA stores the least significant register first and each register little-endian.
+	DCBA
+)
+
+// String returns ABCD, CDAB, BADC, DCBA or WordOrder(n).
+func (o WordOrder) String() string {
+	switch o {
+	case ABCD:
+		return "ABCD"
+	case CDAB:
+		return "CDAB"
+	case BADC:
+		return "BADC"
+	case DCBA:
+		return "DCBA"
+	default:
+		return "WordOrder(" + strconv.Itoa(int(o)) + ")"
+	}
+}
+
+// view returns the byte order of the bytes of a value, after its registers have been serialized big-endian.
+// Unknown word orders are treated as ABCD.
+func (o WordOrder) view(b []byte) byteorder.ByteOrder {
+	switch o {
+	case CDAB:
+		return byteorder.CDAB(b)
+	case BADC:
+		return byteorder.BADC(b)
+	case DCBA:
+		return byteorder.LE(b)
+	default:
+		return byteorder.BE(b)
+	}
+}
+
+// Registers returns the amount of registers which hold a value of n bytes.
+func Registers(n int) int {
+	return (n + 1) / 2 //nolint:gomnd
+}
+
+// checkRange returns a RangeError if regs holds less than the Registers(n) registers of a value of n bytes.
+func checkRange(regs []uint16, n int) error {
+	if k := Registers(n); len(regs) < k {
+		return &RangeError{Needed: k, Available: len(regs)}
+	}
+
+	return nil
+}
+
+// decode decodes the first Registers(n) registers as an unsigned value of n bytes. The caller must ensure that regs
+// is large enough.
+func (o WordOrder) decode(regs []uint16, n int) uint64 {
+	k := Registers(n)
+
+	var tmp [8]byte
+
+	for i, r := range regs[:k] {
+		byteorder.BE(tmp[2*i:]).WriteUint16(r)
+	}
+
+	var v uint64
+
+	switch b := o.view(tmp[:2*k]); k {
+	case 1:
+		v = uint64(b.ReadUint16())
+	case 2: //nolint:gomnd
+		v = uint64(b.ReadUint32())
+	case 3: //nolint:gomnd
+		v = b.ReadUint48()
+	default:
+		v = b.ReadUint64()
+	}
+
+	return v & (math.MaxUint64 >> (64 - 8*n))
+}
+
+// decodeInt decodes the first Registers(n) registers as a sign extended two's complement value of n bytes. The
+// caller must ensure that regs is large enough.
+func (o WordOrder) decodeInt(regs []uint16, n int) int64 {
+	shift := 64 - 8*n
+
+	return int64(o.decode(regs, n)<<shift) >> shift
+}
+
+// encode encodes the lower n bytes of v into the first Registers(n) registers. The caller must ensure that regs is
+// large enough.
+func (o WordOrder) encode(regs []uint16, n int, v uint64) {
+	k := Registers(n)
+	v &= math.MaxUint64 >> (64 - 8*n)
+
+	var tmp [8]byte
+
+	switch b := o.view(tmp[:2*k]); k {
+	case 1:
+		b.WriteUint16(uint16(v))
+	case 2: //nolint:gomnd
+		b.WriteUint32(uint32(v))
+	case 3: //nolint:gomnd
+		b.WriteUint48(v)
+	default:
+		b.WriteUint64(v)
+	}
+
+	for i := range regs[:k] {
+		regs[i] = byteorder.BE(tmp[2*i:]).ReadUint16()
+	}
+}
+
+// read decodes the first Registers(n) registers as an unsigned value of n bytes.
+func (o WordOrder) read(regs []uint16, n int) (uint64, error) {
+	if err := checkRange(regs, n); err != nil {
+		return 0, err
+	}
+
+	return o.decode(regs, n), nil
+}
+
+// readInt decodes the first Registers(n) registers as a sign extended two's complement value of n bytes.
+func (o WordOrder) readInt(regs []uint16, n int) (int64, error) {
+	if err := checkRange(regs, n); err != nil {
+		return 0, err
+	}
+
+	return o.decodeInt(regs, n), nil
+}
+
+// write encodes v into the first Registers(n) registers. Returns a byteorder.OverflowError carrying value, if v
+// does not fit into n bytes.
+func (o WordOrder) write(regs []uint16, n int, v uint64, value interface{}) error {
+	if v > math.MaxUint64>>(64-8*n) {
+		return &byteorder.OverflowError{Value: value, Width: 8 * n}
+	}
+
+	if err := checkRange(regs, n); err != nil {
+		return err
+	}
+
+	o.encode(regs, n, v)
+
+	return nil
+}
+
+// writeInt encodes v into the first Registers(n) registers. Returns a byteorder.OverflowError carrying value, if v
+// does not fit into n bytes.
+func (o WordOrder) writeInt(regs []uint16, n int, v int64, value interface{}) error {
+	shift := 64 - 8*n
+	if v<<shift>>shift != v {
+		return &byteorder.OverflowError{Value: value, Width: 8 * n}
+	}
+
+	if err := checkRange(regs, n); err != nil {
+		return err
+	}
+
+	o.encode(regs, n, uint64(v))
+
+	return nil
+}
+
+// ReadUint16 reads the first register.
+func (o WordOrder) ReadUint16(regs []uint16) (uint16, error) {
+	v, err := o.read(regs, 2) //nolint:gomnd
+
+	return uint16(v), err
+}
+
+// WriteUint16 writes the first register.
+func (o WordOrder) WriteUint16(regs []uint16, v uint16) error {
+	return o.write(regs, 2, uint64(v), v) //nolint:gomnd
+}
+
+// ReadInt16 reads the first register as a signed two's complement integer.
+func (o WordOrder) ReadInt16(regs []uint16) (int16, error) {
+	v, err := o.readInt(regs, 2) //nolint:gomnd
+
+	return int16(v), err
+}
+
+// WriteInt16 writes the first register.
+func (o WordOrder) WriteInt16(regs []uint16, v int16) error {
+	return o.writeInt(regs, 2, int64(v), v) //nolint:gomnd
+}
+
+// ReadUint24 reads the first 2 registers.
+func (o WordOrder) ReadUint24(regs []uint16) (uint32, error) {
+	v, err := o.read(regs, 3) //nolint:gomnd
+
+	return uint32(v), err
+}
+
+// WriteUint24 writes the first 2 registers. Returns a byteorder.OverflowError if v > MaxUint24.
+func (o WordOrder) WriteUint24(regs []uint16, v uint32) error {
+	return o.write(regs, 3, uint64(v), v) //nolint:gomnd
+}
+
+// ReadInt24 reads the first 2 registers as a signed two's complement integer.
+func (o WordOrder) ReadInt24(regs []uint16) (int32, error) {
+	v, err := o.readInt(regs, 3) //nolint:gomnd
+
+	return int32(v), err
+}
+
+// WriteInt24 writes the first 2 registers. Returns a byteorder.OverflowError if v is not within
+// [MinInt24, MaxInt24].
+func (o WordOrder) WriteInt24(regs []uint16, v int32) error {
+	return o.writeInt(regs, 3, int64(v), v) //nolint:gomnd
+}
+
+// ReadUint32 reads the first 2 registers.
+func (o WordOrder) ReadUint32(regs []uint16) (uint32, error) {
+	v, err := o.read(regs, 4) //nolint:gomnd
+
+	return uint32(v), err
+}
+
+// WriteUint32 writes the first 2 registers.
+func (o WordOrder) WriteUint32(regs []uint16, v uint32) error {
+	return o.write(regs, 4, uint64(v), v) //nolint:gomnd
+}
+
+// ReadInt32 reads the first 2 registers as a signed two's complement integer.
+func (o WordOrder) ReadInt32(regs []uint16) (int32, error) {
+	v, err := o.readInt(regs, 4) //nolint:gomnd
+
+	return int32(v), err
+}
+
+// WriteInt32 writes the first 2 registers.
+func (o WordOrder) WriteInt32(regs []uint16, v int32) error {
+	return o.writeInt(regs, 4, int64(v), v) //nolint:gomnd
+}
+
+// ReadUint40 reads the first 3 registers.
+func (o WordOrder) ReadUint40(regs []uint16) (uint64, error) {
+	v, err := o.read(regs, 5) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteUint40 writes the first 3 registers. Returns a byteorder.OverflowError if v > MaxUint40.
+func (o WordOrder) WriteUint40(regs []uint16, v uint64) error {
+	return o.write(regs, 5, v, v) //nolint:gomnd
+}
+
+// ReadInt40 reads the first 3 registers as a signed two's complement integer.
+func (o WordOrder) ReadInt40(regs []uint16) (int64, error) {
+	v, err := o.readInt(regs, 5) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteInt40 writes the first 3 registers. Returns a byteorder.OverflowError if v is not within
+// [MinInt40, MaxInt40].
+func (o WordOrder) WriteInt40(regs []uint16, v int64) error {
+	return o.writeInt(regs, 5, v, v) //nolint:gomnd
+}
+
+// ReadUint48 reads the first 3 registers.
+func (o WordOrder) ReadUint48(regs []uint16) (uint64, error) {
+	v, err := o.read(regs, 6) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteUint48 writes the first 3 registers. Returns a byteorder.OverflowError if v > MaxUint48.
+func (o WordOrder) WriteUint48(regs []uint16, v uint64) error {
+	return o.write(regs, 6, v, v) //nolint:gomnd
+}
+
+// ReadInt48 reads the first 3 registers as a signed two's complement integer.
+func (o WordOrder) ReadInt48(regs []uint16) (int64, error) {
+	v, err := o.readInt(regs, 6) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteInt48 writes the first 3 registers. Returns a byteorder.OverflowError if v is not within
+// [MinInt48, MaxInt48].
+func (o WordOrder) WriteInt48(regs []uint16, v int64) error {
+	return o.writeInt(regs, 6, v, v) //nolint:gomnd
+}
+
+// ReadUint56 reads the first 4 registers.
+func (o WordOrder) ReadUint56(regs []uint16) (uint64, error) {
+	v, err := o.read(regs, 7) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteUint56 writes the first 4 registers. Returns a byteorder.OverflowError if v > MaxUint56.
+func (o WordOrder) WriteUint56(regs []uint16, v uint64) error {
+	return o.write(regs, 7, v, v) //nolint:gomnd
+}
+
+// ReadInt56 reads the first 4 registers as a signed two's complement integer.
+func (o WordOrder) ReadInt56(regs []uint16) (int64, error) {
+	v, err := o.readInt(regs, 7) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteInt56 writes the first 4 registers. Returns a byteorder.OverflowError if v is not within
+// [MinInt56, MaxInt56].
+func (o WordOrder) WriteInt56(regs []uint16, v int64) error {
+	return o.writeInt(regs, 7, v, v) //nolint:gomnd
+}
+
+// ReadUint64 reads the first 4 registers.
+func (o WordOrder) ReadUint64(regs []uint16) (uint64, error) {
+	v, err := o.read(regs, 8) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteUint64 writes the first 4 registers.
+func (o WordOrder) WriteUint64(regs []uint16, v uint64) error {
+	return o.write(regs, 8, v, v) //nolint:gomnd
+}
+
+// ReadInt64 reads the first 4 registers as a signed two's complement integer.
+func (o WordOrder) ReadInt64(regs []uint16) (int64, error) {
+	v, err := o.readInt(regs, 8) //nolint:gomnd
+
+	return v, err
+}
+
+// WriteInt64 writes the first 4 registers.
+func (o WordOrder) WriteInt64(regs []uint16, v int64) error {
+	return o.writeInt(regs, 8, v, v) //nolint:gomnd
+}
+
+// ReadFloat32 reads the first 2 registers as an IEEE 754 binary32.
+func (o WordOrder) ReadFloat32(regs []uint16) (float32, error) {
+	v, err := o.ReadUint32(regs)
+
+	return math.Float32frombits(v), err
+}
+
+// WriteFloat32 writes the first 2 registers as an IEEE 754 binary32.
+func (o WordOrder) WriteFloat32(regs []uint16, v float32) error {
+	return o.WriteUint32(regs, math.Float32bits(v))
+}
+
+// ReadFloat64 reads the first 4 registers as an IEEE 754 binary64.
+func (o WordOrder) ReadFloat64(regs []uint16) (float64, error) {
+	v, err := o.ReadUint64(regs)
+
+	return math.Float64frombits(v), err
+}
+
+// WriteFloat64 writes the first 4 registers as an IEEE 754 binary64.
+func (o WordOrder) WriteFloat64(regs []uint16, v float64) error {
+	return o.WriteUint64(regs, math.Float64bits(v))
+}
+
+// ReadUint16s reads all registers as consecutive values of a single register each.
+func (o WordOrder) ReadUint16s(regs []uint16) []uint16 {
+	values := make([]uint16, len(regs))
+	for i := range values {
+		values[i] = uint16(o.decode(regs[i:], 2)) //nolint:gomnd
+	}
+
+	return values
+}
+
+// WriteUint16s writes all values into consecutive registers. Returns a RangeError if regs is too small.
+func (o WordOrder) WriteUint16s(regs []uint16, values []uint16) error {
+	if len(regs) < len(values) {
+		return &RangeError{Needed: len(values), Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i:], 2, uint64(v)) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadInt16s reads all registers as consecutive values of a single register each.
+func (o WordOrder) ReadInt16s(regs []uint16) []int16 {
+	values := make([]int16, len(regs))
+	for i := range values {
+		values[i] = int16(o.decodeInt(regs[i:], 2)) //nolint:gomnd
+	}
+
+	return values
+}
+
+// WriteInt16s writes all values into consecutive registers. Returns a RangeError if regs is too small.
+func (o WordOrder) WriteInt16s(regs []uint16, values []int16) error {
+	if len(regs) < len(values) {
+		return &RangeError{Needed: len(values), Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i:], 2, uint64(v)) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadUint32s reads all registers as consecutive values of 2 registers each. Returns a RangeError if the length of
+// regs is not a multiple of 2.
+func (o WordOrder) ReadUint32s(regs []uint16) ([]uint32, error) {
+	if len(regs)%2 != 0 {
+		return nil, &RangeError{Needed: 2, Available: len(regs), Misaligned: true}
+	}
+
+	values := make([]uint32, len(regs)/2)
+	for i := range values {
+		values[i] = uint32(o.decode(regs[i*2:], 4)) //nolint:gomnd
+	}
+
+	return values, nil
+}
+
+// WriteUint32s writes all values into consecutive ranges of 2 registers each. Returns a RangeError if regs is too
+// small.
+func (o WordOrder) WriteUint32s(regs []uint16, values []uint32) error {
+	if len(regs) < len(values)*2 {
+		return &RangeError{Needed: len(values) * 2, Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i*2:], 4, uint64(v)) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadInt32s reads all registers as consecutive values of 2 registers each. Returns a RangeError if the length of
+// regs is not a multiple of 2.
+func (o WordOrder) ReadInt32s(regs []uint16) ([]int32, error) {
+	if len(regs)%2 != 0 {
+		return nil, &RangeError{Needed: 2, Available: len(regs), Misaligned: true}
+	}
+
+	values := make([]int32, len(regs)/2)
+	for i := range values {
+		values[i] = int32(o.decodeInt(regs[i*2:], 4)) //nolint:gomnd
+	}
+
+	return values, nil
+}
+
+// WriteInt32s writes all values into consecutive ranges of 2 registers each. Returns a RangeError if regs is too
+// small.
+func (o WordOrder) WriteInt32s(regs []uint16, values []int32) error {
+	if len(regs) < len(values)*2 {
+		return &RangeError{Needed: len(values) * 2, Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i*2:], 4, uint64(v)) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadUint64s reads all registers as consecutive values of 4 registers each. Returns a RangeError if the length of
+// regs is not a multiple of 4.
+func (o WordOrder) ReadUint64s(regs []uint16) ([]uint64, error) {
+	if len(regs)%4 != 0 {
+		return nil, &RangeError{Needed: 4, Available: len(regs), Misaligned: true}
+	}
+
+	values := make([]uint64, len(regs)/4)
+	for i := range values {
+		values[i] = o.decode(regs[i*4:], 8) //nolint:gomnd
+	}
+
+	return values, nil
+}
+
+// WriteUint64s writes all values into consecutive ranges of 4 registers each. Returns a RangeError if regs is too
+// small.
+func (o WordOrder) WriteUint64s(regs []uint16, values []uint64) error {
+	if len(regs) < len(values)*4 {
+		return &RangeError{Needed: len(values) * 4, Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i*4:], 8, v) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadInt64s reads all registers as consecutive values of 4 registers each. Returns a RangeError if the length of
+// regs is not a multiple of 4.
+func (o WordOrder) ReadInt64s(regs []uint16) ([]int64, error) {
+	if len(regs)%4 != 0 {
+		return nil, &RangeError{Needed: 4, Available: len(regs), Misaligned: true}
+	}
+
+	values := make([]int64, len(regs)/4)
+	for i := range values {
+		values[i] = o.decodeInt(regs[i*4:], 8) //nolint:gomnd
+	}
+
+	return values, nil
+}
+
+// WriteInt64s writes all values into consecutive ranges of 4 registers each. Returns a RangeError if regs is too
+// small.
+func (o WordOrder) WriteInt64s(regs []uint16, values []int64) error {
+	if len(regs) < len(values)*4 {
+		return &RangeError{Needed: len(values) * 4, Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i*4:], 8, uint64(v)) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadFloat32s reads all registers as consecutive values of 2 registers each. Returns a RangeError if the length of
+// regs is not a multiple of 2.
+func (o WordOrder) ReadFloat32s(regs []uint16) ([]float32, error) {
+	if len(regs)%2 != 0 {
+		return nil, &RangeError{Needed: 2, Available: len(regs), Misaligned: true}
+	}
+
+	values := make([]float32, len(regs)/2)
+	for i := range values {
+		values[i] = math.Float32frombits(uint32(o.decode(regs[i*2:], 4))) //nolint:gomnd
+	}
+
+	return values, nil
+}
+
+// WriteFloat32s writes all values into consecutive ranges of 2 registers each. Returns a RangeError if regs is too
+// small.
+func (o WordOrder) WriteFloat32s(regs []uint16, values []float32) error {
+	if len(regs) < len(values)*2 {
+		return &RangeError{Needed: len(values) * 2, Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i*2:], 4, uint64(math.Float32bits(v))) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadFloat64s reads all registers as consecutive values of 4 registers each. Returns a RangeError if the length of
+// regs is not a multiple of 4.
+func (o WordOrder) ReadFloat64s(regs []uint16) ([]float64, error) {
+	if len(regs)%4 != 0 {
+		return nil, &RangeError{Needed: 4, Available: len(regs), Misaligned: true}
+	}
+
+	values := make([]float64, len(regs)/4)
+	for i := range values {
+		values[i] = math.Float64frombits(o.decode(regs[i*4:], 8)) //nolint:gomnd
+	}
+
+	return values, nil
+}
+
+// WriteFloat64s writes all values into consecutive ranges of 4 registers each. Returns a RangeError if regs is too
+// small.
+func (o WordOrder) WriteFloat64s(regs []uint16, values []float64) error {
+	if len(regs) < len(values)*4 {
+		return &RangeError{Needed: len(values) * 4, Available: len(regs)}
+	}
+
+	for i, v := range values {
+		o.encode(regs[i*4:], 8, math.Float64bits(v)) //nolint:gomnd
+	}
+
+	return nil
+}
+
+// ReadString reads all registers as ASCII characters, 2 per register, and removes trailing NUL characters. The
+// word order only determines whether the first character is stored in the high byte (ABCD, CDAB) or in the low byte
+// (BADC, DCBA) of each register. Returns ErrNotASCII if a character is not ASCII.
+func (o WordOrder) ReadString(regs []uint16) (string, error) {
+	buf := make([]byte, 2*len(regs))
+	for i, r := range regs {
+		o.view(buf[2*i:]).WriteUint16(r)
+	}
+
+	for len(buf) > 0 && buf[len(buf)-1] == 0 {
+		buf = buf[:len(buf)-1]
+	}
+
+	for _, c := range buf {
+		if c >= 0x80 { //nolint:gomnd
+			return "", ErrNotASCII
+		}
+	}
+
+	return string(buf), nil
+}
+
+// WriteString writes s as ASCII characters, 2 per register, and pads all remaining registers with NUL characters.
+// See ReadString for the character order. Returns a RangeError if regs is too small and ErrNotASCII if a character
+// is not ASCII.
+func (o WordOrder) WriteString(regs []uint16, s string) error {
+	if k := Registers(len(s)); len(regs) < k {
+		return &RangeError{Needed: k, Available: len(regs)}
+	}
+
+	buf := make([]byte, 2*len(regs))
+
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 { //nolint:gomnd
+			return ErrNotASCII
+		}
+
+		buf[i] = s[i]
+	}
+
+	for i := range regs {
+		regs[i] = o.view(buf[2*i:]).ReadUint16()
+	}
+
+	return nil
+}
@@ -0,0 +1,334 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registers_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/worldiety/byteorder"
+	. "github.com/worldiety/byteorder/registers"
+)
+
+func TestWordOrder(t *testing.T) {
+	tests := []struct {
+		order    WordOrder
+		u32      []uint16
+		u24      []uint16
+		u64      []uint16
+		f32      []uint16
+		expected string
+	}{
+		{ABCD, []uint16{0x1122, 0x3344}, []uint16{0x0022, 0x3344}, []uint16{0x1122, 0x3344, 0x5566, 0x7788},
+			[]uint16{0x3FC0, 0x0000}, "ABCD"},
+		{CDAB, []uint16{0x3344, 0x1122}, []uint16{0x3344, 0x0022}, []uint16{0x7788, 0x5566, 0x3344, 0x1122},
+			[]uint16{0x0000, 0x3FC0}, "CDAB"},
+		{BADC, []uint16{0x2211, 0x4433}, []uint16{0x2200, 0x4433}, []uint16{0x2211, 0x4433, 0x6655, 0x8877},
+			[]uint16{0xC03F, 0x0000}, "BADC"},
+		{DCBA, []uint16{0x4433, 0x2211}, []uint16{0x4433, 0x2200}, []uint16{0x8877, 0x6655, 0x4433, 0x2211},
+			[]uint16{0x0000, 0xC03F}, "DCBA"},
+	}
+
+	for _, tt := range tests {
+		regs := make([]uint16, 4)
+
+		if err := tt.order.WriteUint32(regs, 0x11223344); err != nil || !reflect.DeepEqual(regs[:2], tt.u32) {
+			t.Fatalf("%v: expected %x but got %x (%v)", tt.order, tt.u32, regs[:2], err)
+		}
+
+		if v, err := tt.order.ReadUint32(tt.u32); err != nil || v != 0x11223344 {
+			t.Fatalf("%v: expected 11223344 but got %x (%v)", tt.order, v, err)
+		}
+
+		if err := tt.order.WriteUint24(regs, 0x223344); err != nil || !reflect.DeepEqual(regs[:2], tt.u24) {
+			t.Fatalf("%v: expected %x but got %x (%v)", tt.order, tt.u24, regs[:2], err)
+		}
+
+		if v, err := tt.order.ReadUint24(tt.u32); err != nil || v != 0x223344 {
+			t.Fatalf("%v: expected 223344 but got %x (%v)", tt.order, v, err)
+		}
+
+		if err := tt.order.WriteUint64(regs, 0x1122334455667788); err != nil || !reflect.DeepEqual(regs, tt.u64) {
+			t.Fatalf("%v: expected %x but got %x (%v)", tt.order, tt.u64, regs, err)
+		}
+
+		if err := tt.order.WriteFloat32(regs, 1.5); err != nil || !reflect.DeepEqual(regs[:2], tt.f32) {
+			t.Fatalf("%v: expected %x but got %x (%v)", tt.order, tt.f32, regs[:2], err)
+		}
+
+		if v, err := tt.order.ReadFloat32(tt.f32); err != nil || v != 1.5 {
+			t.Fatalf("%v: expected 1.5 but got %v (%v)", tt.order, v, err)
+		}
+
+		if tt.order.String() != tt.expected {
+			t.Fatalf("expected %s but got %s", tt.expected, tt.order)
+		}
+	}
+
+	if WordOrder(9).String() != "WordOrder(9)" {
+		t.Fatalf("unexpected string %s", WordOrder(9))
+	}
+}
+
+func TestWidths(t *testing.T) {
+	for _, order := range []WordOrder{ABCD, CDAB, BADC, DCBA} {
+		regs := make([]uint16, 4)
+
+		assertUints(t, order, regs)
+		assertInts(t, order, regs)
+
+		if err := order.WriteFloat64(regs, math.Pi); err != nil {
+			t.Fatal(err)
+		}
+
+		if v, err := order.ReadFloat64(regs); err != nil || v != math.Pi {
+			t.Fatalf("%v: expected pi but got %v (%v)", order, v, err)
+		}
+	}
+}
+
+func assertUints(t *testing.T, order WordOrder, regs []uint16) {
+	t.Helper()
+
+	for _, tt := range []struct {
+		regs  int
+		max   uint64
+		write func(regs []uint16, v uint64) error
+		read  func(regs []uint16) (uint64, error)
+	}{
+		{1, math.MaxUint16,
+			func(regs []uint16, v uint64) error { return order.WriteUint16(regs, uint16(v)) },
+			func(regs []uint16) (uint64, error) { v, err := order.ReadUint16(regs); return uint64(v), err }},
+		{2, uint64(byteorder.MaxUint24),
+			func(regs []uint16, v uint64) error { return order.WriteUint24(regs, uint32(v)) },
+			func(regs []uint16) (uint64, error) { v, err := order.ReadUint24(regs); return uint64(v), err }},
+		{2, math.MaxUint32,
+			func(regs []uint16, v uint64) error { return order.WriteUint32(regs, uint32(v)) },
+			func(regs []uint16) (uint64, error) { v, err := order.ReadUint32(regs); return uint64(v), err }},
+		{3, byteorder.MaxUint40, order.WriteUint40, order.ReadUint40},
+		{3, byteorder.MaxUint48, order.WriteUint48, order.ReadUint48},
+		{4, byteorder.MaxUint56, order.WriteUint56, order.ReadUint56},
+		{4, math.MaxUint64, order.WriteUint64, order.ReadUint64},
+	} {
+		for _, v := range []uint64{0, 1, tt.max} {
+			if err := tt.write(regs, v); err != nil {
+				t.Fatalf("%v: unexpected error %v", order, err)
+			}
+
+			if actual, err := tt.read(regs); err != nil || actual != v {
+				t.Fatalf("%v: expected %d but got %d (%v)", order, v, actual, err)
+			}
+		}
+
+		if err := tt.write(regs[:tt.regs-1], 0); !errors.Is(err, ErrShortRange) {
+			t.Fatalf("%v: expected short range but got %v", order, err)
+		}
+
+		if _, err := tt.read(regs[:tt.regs-1]); !errors.Is(err, ErrShortRange) {
+			t.Fatalf("%v: expected short range but got %v", order, err)
+		}
+	}
+}
+
+func assertInts(t *testing.T, order WordOrder, regs []uint16) {
+	t.Helper()
+
+	for _, tt := range []struct {
+		regs     int
+		min, max int64
+		write    func(regs []uint16, v int64) error
+		read     func(regs []uint16) (int64, error)
+	}{
+		{1, math.MinInt16, math.MaxInt16,
+			func(regs []uint16, v int64) error { return order.WriteInt16(regs, int16(v)) },
+			func(regs []uint16) (int64, error) { v, err := order.ReadInt16(regs); return int64(v), err }},
+		{2, int64(byteorder.MinInt24), int64(byteorder.MaxInt24),
+			func(regs []uint16, v int64) error { return order.WriteInt24(regs, int32(v)) },
+			func(regs []uint16) (int64, error) { v, err := order.ReadInt24(regs); return int64(v), err }},
+		{2, math.MinInt32, math.MaxInt32,
+			func(regs []uint16, v int64) error { return order.WriteInt32(regs, int32(v)) },
+			func(regs []uint16) (int64, error) { v, err := order.ReadInt32(regs); return int64(v), err }},
+		{3, byteorder.MinInt40, byteorder.MaxInt40, order.WriteInt40, order.ReadInt40},
+		{3, byteorder.MinInt48, byteorder.MaxInt48, order.WriteInt48, order.ReadInt48},
+		{4, byteorder.MinInt56, byteorder.MaxInt56, order.WriteInt56, order.ReadInt56},
+		{4, math.MinInt64, math.MaxInt64, order.WriteInt64, order.ReadInt64},
+	} {
+		for _, v := range []int64{tt.min, -1, 0, tt.max} {
+			if err := tt.write(regs, v); err != nil {
+				t.Fatalf("%v: unexpected error %v", order, err)
+			}
+
+			if actual, err := tt.read(regs); err != nil || actual != v {
+				t.Fatalf("%v: expected %d but got %d (%v)", order, v, actual, err)
+			}
+		}
+
+		if err := tt.write(regs[:tt.regs-1], 0); !errors.Is(err, ErrShortRange) {
+			t.Fatalf("%v: expected short range but got %v", order, err)
+		}
+
+		if _, err := tt.read(regs[:tt.regs-1]); !errors.Is(err, ErrShortRange) {
+			t.Fatalf("%v: expected short range but got %v", order, err)
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	regs := make([]uint16, 4)
+
+	for _, err := range []error{
+		ABCD.WriteUint24(regs, byteorder.MaxUint24+1),
+		CDAB.WriteUint40(regs, byteorder.MaxUint40+1),
+		BADC.WriteInt24(regs, byteorder.MinInt24-1),
+		DCBA.WriteInt56(regs, byteorder.MaxInt56+1),
+	} {
+		if !errors.Is(err, byteorder.ErrOverflow) {
+			t.Fatalf("expected overflow but got %v", err)
+		}
+	}
+}
+
+func TestBulk(t *testing.T) {
+	regs := make([]uint16, 8)
+
+	if err := CDAB.WriteFloat32s(regs, []float32{1, -2, 3.5, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := CDAB.ReadFloat32s(regs); err != nil || !reflect.DeepEqual(v, []float32{1, -2, 3.5, 4}) {
+		t.Fatalf("unexpected floats %v (%v)", v, err)
+	}
+
+	if err := ABCD.WriteFloat64s(regs, []float64{math.Pi, math.E}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := ABCD.ReadFloat64s(regs); err != nil || !reflect.DeepEqual(v, []float64{math.Pi, math.E}) {
+		t.Fatalf("unexpected floats %v (%v)", v, err)
+	}
+
+	if err := BADC.WriteUint32s(regs, []uint32{1, 2, math.MaxUint32}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := BADC.ReadUint32s(regs[:6]); err != nil || !reflect.DeepEqual(v, []uint32{1, 2, math.MaxUint32}) {
+		t.Fatalf("unexpected values %v (%v)", v, err)
+	}
+
+	if err := DCBA.WriteInt32s(regs, []int32{-1, math.MinInt32}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := DCBA.ReadInt32s(regs[:4]); err != nil || !reflect.DeepEqual(v, []int32{-1, math.MinInt32}) {
+		t.Fatalf("unexpected values %v (%v)", v, err)
+	}
+
+	if err := CDAB.WriteUint16s(regs, []uint16{1, 0xABCD}); err != nil || regs[1] != 0xABCD {
+		t.Fatalf("unexpected registers %X (%v)", regs, err)
+	}
+
+	if v := BADC.ReadUint16s(regs[:2]); !reflect.DeepEqual(v, []uint16{0x0100, 0xCDAB}) {
+		t.Fatalf("unexpected values %v", v)
+	}
+
+	if err := DCBA.WriteInt16s(regs, []int16{-2, math.MinInt16, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := DCBA.ReadInt16s(regs[:3]); !reflect.DeepEqual(v, []int16{-2, math.MinInt16, 3}) {
+		t.Fatalf("unexpected values %v", v)
+	}
+
+	if err := CDAB.WriteUint64s(regs, []uint64{1, math.MaxUint64}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := CDAB.ReadUint64s(regs); err != nil || !reflect.DeepEqual(v, []uint64{1, math.MaxUint64}) {
+		t.Fatalf("unexpected values %v (%v)", v, err)
+	}
+
+	if err := BADC.WriteInt64s(regs, []int64{math.MinInt64, -1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := BADC.ReadInt64s(regs); err != nil || !reflect.DeepEqual(v, []int64{math.MinInt64, -1}) {
+		t.Fatalf("unexpected values %v (%v)", v, err)
+	}
+
+	for _, err := range []error{
+		ABCD.WriteUint16s(regs, make([]uint16, 9)),
+		ABCD.WriteInt16s(regs, make([]int16, 9)),
+		ABCD.WriteUint64s(regs, make([]uint64, 3)),
+		ABCD.WriteInt64s(regs, make([]int64, 3)),
+		ABCD.WriteFloat32s(regs, make([]float32, 5)),
+		ABCD.WriteFloat64s(regs, make([]float64, 3)),
+		ABCD.WriteUint32s(regs, make([]uint32, 5)),
+		ABCD.WriteInt32s(regs, make([]int32, 5)),
+	} {
+		if !errors.Is(err, ErrShortRange) || err.Error() == "" {
+			t.Fatalf("expected short range but got %v", err)
+		}
+	}
+
+	for _, f := range []func() error{
+		func() error { _, err := ABCD.ReadFloat32s(regs[:3]); return err },
+		func() error { _, err := ABCD.ReadFloat64s(regs[:6]); return err },
+		func() error { _, err := ABCD.ReadUint32s(regs[:1]); return err },
+		func() error { _, err := ABCD.ReadInt32s(regs[:5]); return err },
+		func() error { _, err := ABCD.ReadUint64s(regs[:7]); return err },
+		func() error { _, err := ABCD.ReadInt64s(regs[:2]); return err },
+	} {
+		if err := f(); !errors.Is(err, ErrMisaligned) || errors.Is(err, ErrShortRange) || err.Error() == "" {
+			t.Fatalf("expected misaligned range but got %v", err)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	regs := make([]uint16, 4)
+
+	if err := ABCD.WriteString(regs, "Modbus!"); err != nil || !reflect.DeepEqual(regs, []uint16{0x4D6F, 0x6462, 0x7573,
+		0x2100}) {
+		t.Fatalf("unexpected registers %x (%v)", regs, err)
+	}
+
+	if s, err := CDAB.ReadString(regs); err != nil || s != "Modbus!" {
+		t.Fatalf("expected Modbus! but got %q (%v)", s, err)
+	}
+
+	if err := DCBA.WriteString(regs, "ab"); err != nil || !reflect.DeepEqual(regs, []uint16{0x6261, 0, 0, 0}) {
+		t.Fatalf("unexpected registers %x (%v)", regs, err)
+	}
+
+	if s, err := BADC.ReadString(regs); err != nil || s != "ab" {
+		t.Fatalf("expected ab but got %q (%v)", s, err)
+	}
+
+	if err := ABCD.WriteString(regs, "too long string"); !errors.Is(err, ErrShortRange) {
+		t.Fatalf("expected short range but got %v", err)
+	}
+
+	if err := ABCD.WriteString(regs, "äh"); !errors.Is(err, ErrNotASCII) {
+		t.Fatalf("expected not ASCII but got %v", err)
+	}
+
+	if _, err := ABCD.ReadString([]uint16{0x41E4}); !errors.Is(err, ErrNotASCII) {
+		t.Fatalf("expected not ASCII but got %v", err)
+	}
+}
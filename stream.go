@@ -0,0 +1,428 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"errors"
+	"io"
+)
+
+// A StreamReader decodes values from an io.Reader in either little-endian or big-endian order, using an internal
+// scratch buffer. If the stream ends in the middle of a value, io.ErrUnexpectedEOF is returned. If the stream
+// ends before the first byte of a value, io.EOF is returned.
+type StreamReader struct {
+	r     io.Reader
+	tmp   [MaxVarintLen64]byte
+	order Order
+}
+
+// NewStreamReader creates a StreamReader which decodes values in the given order from r.
+func NewStreamReader(r io.Reader, order Order) *StreamReader {
+	return &StreamReader{r: r, order: order}
+}
+
+// NewLittleEndianStreamReader creates a StreamReader which decodes little-endian values from r.
+func NewLittleEndianStreamReader(r io.Reader) *StreamReader {
+	return NewStreamReader(r, Little)
+}
+
+// NewBigEndianStreamReader creates a StreamReader which decodes big-endian values from r.
+func NewBigEndianStreamReader(r io.Reader) *StreamReader {
+	return NewStreamReader(r, Big)
+}
+
+// fill reads exactly n bytes into the scratch buffer.
+func (s *StreamReader) fill(n int) ([]byte, error) {
+	b := s.tmp[:n]
+	if _, err := io.ReadFull(s.r, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// fillVarint reads bytes into the scratch buffer until a byte without a continuation bit has been read or
+// MaxVarintLen64 bytes are buffered.
+func (s *StreamReader) fillVarint() ([]byte, error) {
+	for i := 0; i < MaxVarintLen64; i++ {
+		if _, err := io.ReadFull(s.r, s.tmp[i:i+1]); err != nil {
+			if i > 0 && errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return nil, err
+		}
+
+		if s.tmp[i] < 0x80 {
+			return s.tmp[:i+1], nil
+		}
+	}
+
+	return s.tmp[:], nil
+}
+
+// ReadUvarint reads an unsigned LEB128 value. Returns ErrVarintOverflow if the value does not fit into 64 bit.
+func (s *StreamReader) ReadUvarint() (uint64, error) {
+	b, err := s.fillVarint()
+	if err != nil {
+		return 0, err
+	}
+
+	v, _, err := ReadUvarint(b)
+
+	return v, err
+}
+
+// ReadVarint reads a signed LEB128 value. Returns ErrVarintOverflow if the value does not fit into 64 bit.
+func (s *StreamReader) ReadVarint() (int64, error) {
+	b, err := s.fillVarint()
+	if err != nil {
+		return 0, err
+	}
+
+	v, _, err := ReadVarint(b)
+
+	return v, err
+}
+
+// A StreamWriter encodes values in either little-endian or big-endian order into an io.Writer, using an internal
+// scratch buffer. Each value causes a single call to Write, so wrapping w into a bufio.Writer is recommended.
+type StreamWriter struct {
+	w     io.Writer
+	tmp   [MaxVarintLen64]byte
+	order Order
+}
+
+// NewStreamWriter creates a StreamWriter which encodes values in the given order into w.
+func NewStreamWriter(w io.Writer, order Order) *StreamWriter {
+	return &StreamWriter{w: w, order: order}
+}
+
+// NewLittleEndianStreamWriter creates a StreamWriter which encodes little-endian values into w.
+func NewLittleEndianStreamWriter(w io.Writer) *StreamWriter {
+	return NewStreamWriter(w, Little)
+}
+
+// NewBigEndianStreamWriter creates a StreamWriter which encodes big-endian values into w.
+func NewBigEndianStreamWriter(w io.Writer) *StreamWriter {
+	return NewStreamWriter(w, Big)
+}
+
+// flush writes the first n bytes of the scratch buffer.
+func (s *StreamWriter) flush(n int) error {
+	_, err := s.w.Write(s.tmp[:n])
+
+	return err
+}
+
+// WriteUvarint writes the unsigned LEB128 encoding of v.
+func (s *StreamWriter) WriteUvarint(v uint64) error {
+	return s.flush(len(AppendUvarint(s.tmp[:0], v)))
+}
+
+// WriteVarint writes the signed LEB128 encoding of v.
+func (s *StreamWriter) WriteVarint(v int64) error {
+	return s.flush(len(AppendVarint(s.tmp[:0], v)))
+}
+
+// ReadUint16 reads the next 2 bytes.
+func (s *StreamReader) ReadUint16() (uint16, error) {
+	b, err := s.fill(2) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint16(b), nil
+}
+
+// ReadUint24 reads the next 3 bytes.
+func (s *StreamReader) ReadUint24() (uint32, error) {
+	b, err := s.fill(3) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint24(b), nil
+}
+
+// ReadUint32 reads the next 4 bytes.
+func (s *StreamReader) ReadUint32() (uint32, error) {
+	b, err := s.fill(4) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint32(b), nil
+}
+
+// ReadUint40 reads the next 5 bytes.
+func (s *StreamReader) ReadUint40() (uint64, error) {
+	b, err := s.fill(5) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint40(b), nil
+}
+
+// ReadUint48 reads the next 6 bytes.
+func (s *StreamReader) ReadUint48() (uint64, error) {
+	b, err := s.fill(6) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint48(b), nil
+}
+
+// ReadUint56 reads the next 7 bytes.
+func (s *StreamReader) ReadUint56() (uint64, error) {
+	b, err := s.fill(7) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint56(b), nil
+}
+
+// ReadUint64 reads the next 8 bytes.
+func (s *StreamReader) ReadUint64() (uint64, error) {
+	b, err := s.fill(8) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadUint64(b), nil
+}
+
+// ReadInt16 reads the next 2 bytes.
+func (s *StreamReader) ReadInt16() (int16, error) {
+	b, err := s.fill(2) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt16(b), nil
+}
+
+// ReadInt24 reads the next 3 bytes.
+func (s *StreamReader) ReadInt24() (int32, error) {
+	b, err := s.fill(3) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt24(b), nil
+}
+
+// ReadInt32 reads the next 4 bytes.
+func (s *StreamReader) ReadInt32() (int32, error) {
+	b, err := s.fill(4) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt32(b), nil
+}
+
+// ReadInt40 reads the next 5 bytes.
+func (s *StreamReader) ReadInt40() (int64, error) {
+	b, err := s.fill(5) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt40(b), nil
+}
+
+// ReadInt48 reads the next 6 bytes.
+func (s *StreamReader) ReadInt48() (int64, error) {
+	b, err := s.fill(6) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt48(b), nil
+}
+
+// ReadInt56 reads the next 7 bytes.
+func (s *StreamReader) ReadInt56() (int64, error) {
+	b, err := s.fill(7) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt56(b), nil
+}
+
+// ReadInt64 reads the next 8 bytes.
+func (s *StreamReader) ReadInt64() (int64, error) {
+	b, err := s.fill(8) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadInt64(b), nil
+}
+
+// ReadFloat32 reads the next 4 bytes.
+func (s *StreamReader) ReadFloat32() (float32, error) {
+	b, err := s.fill(4) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadFloat32(b), nil
+}
+
+// ReadFloat64 reads the next 8 bytes.
+func (s *StreamReader) ReadFloat64() (float64, error) {
+	b, err := s.fill(8) //nolint:gomnd
+	if err != nil {
+		return 0, err
+	}
+
+	return s.order.ReadFloat64(b), nil
+}
+
+// WriteUint16 writes 2 bytes.
+func (s *StreamWriter) WriteUint16(v uint16) error {
+	s.order.WriteUint16(s.tmp[:], v)
+
+	return s.flush(2) //nolint:gomnd
+}
+
+// WriteUint24 writes 3 bytes.
+func (s *StreamWriter) WriteUint24(v uint32) error {
+	s.order.WriteUint24(s.tmp[:], v)
+
+	return s.flush(3) //nolint:gomnd
+}
+
+// WriteUint32 writes 4 bytes.
+func (s *StreamWriter) WriteUint32(v uint32) error {
+	s.order.WriteUint32(s.tmp[:], v)
+
+	return s.flush(4) //nolint:gomnd
+}
+
+// WriteUint40 writes 5 bytes.
+func (s *StreamWriter) WriteUint40(v uint64) error {
+	s.order.WriteUint40(s.tmp[:], v)
+
+	return s.flush(5) //nolint:gomnd
+}
+
+// WriteUint48 writes 6 bytes.
+func (s *StreamWriter) WriteUint48(v uint64) error {
+	s.order.WriteUint48(s.tmp[:], v)
+
+	return s.flush(6) //nolint:gomnd
+}
+
+// WriteUint56 writes 7 bytes.
+func (s *StreamWriter) WriteUint56(v uint64) error {
+	s.order.WriteUint56(s.tmp[:], v)
+
+	return s.flush(7) //nolint:gomnd
+}
+
+// WriteUint64 writes 8 bytes.
+func (s *StreamWriter) WriteUint64(v uint64) error {
+	s.order.WriteUint64(s.tmp[:], v)
+
+	return s.flush(8) //nolint:gomnd
+}
+
+// WriteInt16 writes 2 bytes.
+func (s *StreamWriter) WriteInt16(v int16) error {
+	s.order.WriteInt16(s.tmp[:], v)
+
+	return s.flush(2) //nolint:gomnd
+}
+
+// WriteInt24 writes 3 bytes. Returns an OverflowError if v is not within [MinInt24, MaxInt24].
+func (s *StreamWriter) WriteInt24(v int32) error {
+	if err := intOverflow(int64(v), int64(MinInt24), int64(MaxInt24), 24); err != nil { //nolint:gomnd
+		return err
+	}
+
+	s.order.WriteInt24(s.tmp[:], v)
+
+	return s.flush(3) //nolint:gomnd
+}
+
+// WriteInt32 writes 4 bytes.
+func (s *StreamWriter) WriteInt32(v int32) error {
+	s.order.WriteInt32(s.tmp[:], v)
+
+	return s.flush(4) //nolint:gomnd
+}
+
+// WriteInt40 writes 5 bytes. Returns an OverflowError if v is not within [MinInt40, MaxInt40].
+func (s *StreamWriter) WriteInt40(v int64) error {
+	if err := intOverflow(v, MinInt40, MaxInt40, 40); err != nil { //nolint:gomnd
+		return err
+	}
+
+	s.order.WriteInt40(s.tmp[:], v)
+
+	return s.flush(5) //nolint:gomnd
+}
+
+// WriteInt48 writes 6 bytes. Returns an OverflowError if v is not within [MinInt48, MaxInt48].
+func (s *StreamWriter) WriteInt48(v int64) error {
+	if err := intOverflow(v, MinInt48, MaxInt48, 48); err != nil { //nolint:gomnd
+		return err
+	}
+
+	s.order.WriteInt48(s.tmp[:], v)
+
+	return s.flush(6) //nolint:gomnd
+}
+
+// WriteInt56 writes 7 bytes. Returns an OverflowError if v is not within [MinInt56, MaxInt56].
+func (s *StreamWriter) WriteInt56(v int64) error {
+	if err := intOverflow(v, MinInt56, MaxInt56, 56); err != nil { //nolint:gomnd
+		return err
+	}
+
+	s.order.WriteInt56(s.tmp[:], v)
+
+	return s.flush(7) //nolint:gomnd
+}
+
+// WriteInt64 writes 8 bytes.
+func (s *StreamWriter) WriteInt64(v int64) error {
+	s.order.WriteInt64(s.tmp[:], v)
+
+	return s.flush(8) //nolint:gomnd
+}
+
+// WriteFloat32 writes 4 bytes.
+func (s *StreamWriter) WriteFloat32(v float32) error {
+	s.order.WriteFloat32(s.tmp[:], v)
+
+	return s.flush(4) //nolint:gomnd
+}
+
+// WriteFloat64 writes 8 bytes.
+func (s *StreamWriter) WriteFloat64(v float64) error {
+	s.order.WriteFloat64(s.tmp[:], v)
+
+	return s.flush(8) //nolint:gomnd
+}
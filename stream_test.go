@@ -0,0 +1,156 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestStream(t *testing.T) {
+	tests := []struct {
+		w func(io.Writer) *StreamWriter
+		r func(io.Reader) *StreamReader
+	}{
+		{NewLittleEndianStreamWriter, NewLittleEndianStreamReader},
+		{NewBigEndianStreamWriter, NewBigEndianStreamReader},
+	}
+
+	for _, tt := range tests {
+		for _, value := range []struct {
+			size      int
+			copyValue func(w *StreamWriter, r *StreamReader) (read, write error)
+		}{
+			{2, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint16()
+				return err, w.WriteUint16(v)
+			}},
+			{3, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint24()
+				return err, w.WriteUint24(v)
+			}},
+			{4, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint32()
+				return err, w.WriteUint32(v)
+			}},
+			{5, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint40()
+				return err, w.WriteUint40(v)
+			}},
+			{6, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint48()
+				return err, w.WriteUint48(v)
+			}},
+			{7, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint56()
+				return err, w.WriteUint56(v)
+			}},
+			{8, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadUint64()
+				return err, w.WriteUint64(v)
+			}},
+			{2, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt16()
+				return err, w.WriteInt16(v)
+			}},
+			{3, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt24()
+				return err, w.WriteInt24(v)
+			}},
+			{4, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt32()
+				return err, w.WriteInt32(v)
+			}},
+			{5, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt40()
+				return err, w.WriteInt40(v)
+			}},
+			{6, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt48()
+				return err, w.WriteInt48(v)
+			}},
+			{7, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt56()
+				return err, w.WriteInt56(v)
+			}},
+			{8, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadInt64()
+				return err, w.WriteInt64(v)
+			}},
+			{4, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadFloat32()
+				return err, w.WriteFloat32(v)
+			}},
+			{8, func(w *StreamWriter, r *StreamReader) (error, error) {
+				v, err := r.ReadFloat64()
+				return err, w.WriteFloat64(v)
+			}},
+		} {
+			var out bytes.Buffer
+
+			if read, write := value.copyValue(tt.w(&out), tt.r(bytes.NewReader(src))); read != nil || write != nil {
+				t.Fatalf("%d: unexpected errors %v %v", value.size, read, write)
+			}
+
+			assertValues(t, out.Bytes())
+
+			r := tt.r(bytes.NewReader(src[:value.size-1]))
+			read, write := value.copyValue(tt.w(failingWriter{}), r)
+			assertErr(t, read, io.ErrUnexpectedEOF)
+			assertErr(t, write, io.ErrClosedPipe)
+
+			read, _ = value.copyValue(tt.w(ioutil.Discard), r)
+			assertErr(t, read, io.EOF)
+		}
+	}
+}
+
+func assertErr(t *testing.T, err, expected error) {
+	t.Helper()
+
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %v but got %v", expected, err)
+	}
+}
+
+func TestStreamOverflow(t *testing.T) {
+	writers := []*StreamWriter{NewLittleEndianStreamWriter(ioutil.Discard), NewBigEndianStreamWriter(ioutil.Discard)}
+
+	for _, w := range writers {
+		for _, err := range []error{
+			w.WriteInt24(MaxInt24 + 1),
+			w.WriteInt40(MaxInt40 + 1),
+			w.WriteInt48(MinInt48 - 1),
+			w.WriteInt56(MinInt56 - 1),
+		} {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("expected overflow but got %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,386 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import "io"
+
+// A StrictBuffer is like a Buffer but checks every integer against the range of its width. Instead of silently
+// truncating or panicking, an OverflowError is returned and nothing is appended. Integers are accepted as uint64
+// or int64, so that wider values like file offsets can be passed without a truncating conversion. Floats,
+// varints and 128 bit integers cannot overflow and are appended like by a Buffer.
+type StrictBuffer struct {
+	buf Buffer
+}
+
+// NewStrictBuffer creates a StrictBuffer which appends values in the given order to buf. buf may be nil or a
+// slice with some spare capacity, which is reused.
+func NewStrictBuffer(buf []byte, order Order) *StrictBuffer {
+	return &StrictBuffer{buf: Buffer{buf: buf, order: order}}
+}
+
+// NewLittleEndianStrictBuffer creates a StrictBuffer which appends little-endian values to buf. buf may be nil or
+// a slice with some spare capacity, which is reused.
+func NewLittleEndianStrictBuffer(buf []byte) *StrictBuffer {
+	return NewStrictBuffer(buf, Little)
+}
+
+// NewBigEndianStrictBuffer creates a StrictBuffer which appends big-endian values to buf. buf may be nil or
+// a slice with some spare capacity, which is reused.
+func NewBigEndianStrictBuffer(buf []byte) *StrictBuffer {
+	return NewStrictBuffer(buf, Big)
+}
+
+// Order returns the byte order used for encoding.
+func (b *StrictBuffer) Order() Order {
+	return b.buf.Order()
+}
+
+// Bytes returns the appended bytes. The slice is only valid until the next modification of the StrictBuffer.
+func (b *StrictBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// Len returns the amount of appended bytes.
+func (b *StrictBuffer) Len() int {
+	return b.buf.Len()
+}
+
+// Reset discards all appended bytes but keeps the allocated memory for reuse.
+func (b *StrictBuffer) Reset() {
+	b.buf.Reset()
+}
+
+// Grow ensures that at least another n bytes can be appended without a reallocation. Panics when n < 0.
+func (b *StrictBuffer) Grow(n int) {
+	b.buf.Grow(n)
+}
+
+// AppendUint appends n bytes, where n must be within [1, 8]. Returns an OverflowError if v does not fit into
+// n bytes. Panics if n is invalid.
+func (b *StrictBuffer) AppendUint(n int, v uint64) error {
+	if err := uintOverflow(v, n); err != nil {
+		return err
+	}
+
+	b.buf.AppendUint(n, v)
+
+	return nil
+}
+
+// AppendInt appends n bytes, where n must be within [1, 8]. Returns an OverflowError if v does not fit into
+// n bytes. Panics if n is invalid.
+func (b *StrictBuffer) AppendInt(n int, v int64) error {
+	if err := intOverflow(v, minIntOf(n), maxIntOf(n), 8*n); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.buf.AppendInt(n, v)
+
+	return nil
+}
+
+// AppendUint8 appends a single byte. Returns an OverflowError if v > MaxUint8.
+func (b *StrictBuffer) AppendUint8(v uint64) error {
+	return b.AppendUint(1, v)
+}
+
+// AppendUint16 appends 2 bytes. Returns an OverflowError if v > MaxUint16.
+func (b *StrictBuffer) AppendUint16(v uint64) error {
+	return b.AppendUint(2, v) //nolint:gomnd
+}
+
+// AppendUint24 appends 3 bytes. Returns an OverflowError if v > MaxUint24.
+func (b *StrictBuffer) AppendUint24(v uint64) error {
+	return b.AppendUint(3, v) //nolint:gomnd
+}
+
+// AppendUint32 appends 4 bytes. Returns an OverflowError if v > MaxUint32.
+func (b *StrictBuffer) AppendUint32(v uint64) error {
+	return b.AppendUint(4, v) //nolint:gomnd
+}
+
+// AppendUint40 appends 5 bytes. Returns an OverflowError if v > MaxUint40.
+func (b *StrictBuffer) AppendUint40(v uint64) error {
+	return b.AppendUint(5, v) //nolint:gomnd
+}
+
+// AppendUint48 appends 6 bytes. Returns an OverflowError if v > MaxUint48.
+func (b *StrictBuffer) AppendUint48(v uint64) error {
+	return b.AppendUint(6, v) //nolint:gomnd
+}
+
+// AppendUint56 appends 7 bytes. Returns an OverflowError if v > MaxUint56.
+func (b *StrictBuffer) AppendUint56(v uint64) error {
+	return b.AppendUint(7, v) //nolint:gomnd
+}
+
+// AppendUint64 appends 8 bytes.
+func (b *StrictBuffer) AppendUint64(v uint64) error {
+	return b.AppendUint(8, v) //nolint:gomnd
+}
+
+// AppendInt8 appends a single byte. Returns an OverflowError if v is not within [MinInt8, MaxInt8].
+func (b *StrictBuffer) AppendInt8(v int64) error {
+	return b.AppendInt(1, v)
+}
+
+// AppendInt16 appends 2 bytes. Returns an OverflowError if v is not within [MinInt16, MaxInt16].
+func (b *StrictBuffer) AppendInt16(v int64) error {
+	return b.AppendInt(2, v) //nolint:gomnd
+}
+
+// AppendInt24 appends 3 bytes. Returns an OverflowError if v is not within [MinInt24, MaxInt24].
+func (b *StrictBuffer) AppendInt24(v int64) error {
+	return b.AppendInt(3, v) //nolint:gomnd
+}
+
+// AppendInt32 appends 4 bytes. Returns an OverflowError if v is not within [MinInt32, MaxInt32].
+func (b *StrictBuffer) AppendInt32(v int64) error {
+	return b.AppendInt(4, v) //nolint:gomnd
+}
+
+// AppendInt40 appends 5 bytes. Returns an OverflowError if v is not within [MinInt40, MaxInt40].
+func (b *StrictBuffer) AppendInt40(v int64) error {
+	return b.AppendInt(5, v) //nolint:gomnd
+}
+
+// AppendInt48 appends 6 bytes. Returns an OverflowError if v is not within [MinInt48, MaxInt48].
+func (b *StrictBuffer) AppendInt48(v int64) error {
+	return b.AppendInt(6, v) //nolint:gomnd
+}
+
+// AppendInt56 appends 7 bytes. Returns an OverflowError if v is not within [MinInt56, MaxInt56].
+func (b *StrictBuffer) AppendInt56(v int64) error {
+	return b.AppendInt(7, v) //nolint:gomnd
+}
+
+// AppendInt64 appends 8 bytes.
+func (b *StrictBuffer) AppendInt64(v int64) error {
+	return b.AppendInt(8, v) //nolint:gomnd
+}
+
+// AppendFloat32 appends 4 bytes.
+func (b *StrictBuffer) AppendFloat32(v float32) {
+	b.buf.AppendFloat32(v)
+}
+
+// AppendFloat64 appends 8 bytes.
+func (b *StrictBuffer) AppendFloat64(v float64) {
+	b.buf.AppendFloat64(v)
+}
+
+// AppendUvarint appends the unsigned LEB128 encoding of v.
+func (b *StrictBuffer) AppendUvarint(v uint64) {
+	b.buf.AppendUvarint(v)
+}
+
+// AppendVarint appends the signed LEB128 encoding of v.
+func (b *StrictBuffer) AppendVarint(v int64) {
+	b.buf.AppendVarint(v)
+}
+
+// AppendUint128 appends 16 bytes.
+func (b *StrictBuffer) AppendUint128(v Uint128) {
+	b.buf.AppendUint128(v)
+}
+
+// AppendInt128 appends 16 bytes.
+func (b *StrictBuffer) AppendInt128(v Int128) {
+	b.buf.AppendInt128(v)
+}
+
+// AppendFixed appends v.Format.Width() bytes. Returns an OverflowError if v.Raw does not fit into the format.
+// Panics if the format is invalid.
+func (b *StrictBuffer) AppendFixed(v Fixed) error {
+	return b.AppendInt(v.Format.Width(), v.Raw)
+}
+
+// AppendBCD appends v as n bytes of 2*n BCD digits. See Buffer.AppendBCD.
+func (b *StrictBuffer) AppendBCD(n int, v uint64) error {
+	return b.buf.AppendBCD(n, v)
+}
+
+// AppendBCDString appends s as n bytes of 2*n BCD digits. See Buffer.AppendBCDString.
+func (b *StrictBuffer) AppendBCDString(n int, s string) error {
+	return b.buf.AppendBCDString(n, s)
+}
+
+// AppendPacked appends v as a packed decimal of n bytes. See Buffer.AppendPacked.
+func (b *StrictBuffer) AppendPacked(n int, v int64) error {
+	return b.buf.AppendPacked(n, v)
+}
+
+// AppendPackedString appends s as a packed decimal of n bytes. See Buffer.AppendPackedString.
+func (b *StrictBuffer) AppendPackedString(n int, s string) error {
+	return b.buf.AppendPackedString(n, s)
+}
+
+// AppendZoned appends v as a zoned decimal of n bytes. See Buffer.AppendZoned.
+func (b *StrictBuffer) AppendZoned(n int, v int64) error {
+	return b.buf.AppendZoned(n, v)
+}
+
+// AppendZonedString appends s as a zoned decimal of n bytes. See Buffer.AppendZonedString.
+func (b *StrictBuffer) AppendZonedString(n int, s string) error {
+	return b.buf.AppendZonedString(n, s)
+}
+
+// A StrictStreamWriter is like a StreamWriter but checks every integer against the range of its width. Instead of
+// silently truncating, an OverflowError is returned and nothing is written. Integers are accepted as uint64 or
+// int64, like by a StrictBuffer. Floats and varints cannot overflow and are written like by a StreamWriter.
+type StrictStreamWriter struct {
+	w StreamWriter
+}
+
+// NewStrictStreamWriter creates a StrictStreamWriter which encodes values in the given order into w.
+func NewStrictStreamWriter(w io.Writer, order Order) *StrictStreamWriter {
+	return &StrictStreamWriter{w: StreamWriter{w: w, order: order}}
+}
+
+// NewLittleEndianStrictStreamWriter creates a StrictStreamWriter which encodes little-endian values into w.
+func NewLittleEndianStrictStreamWriter(w io.Writer) *StrictStreamWriter {
+	return NewStrictStreamWriter(w, Little)
+}
+
+// NewBigEndianStrictStreamWriter creates a StrictStreamWriter which encodes big-endian values into w.
+func NewBigEndianStrictStreamWriter(w io.Writer) *StrictStreamWriter {
+	return NewStrictStreamWriter(w, Big)
+}
+
+// WriteUint writes n bytes, where n must be within [1, 8]. Returns an OverflowError if v does not fit into n bytes.
+// Panics if n is invalid.
+func (s *StrictStreamWriter) WriteUint(n int, v uint64) error {
+	if err := uintOverflow(v, n); err != nil {
+		return err
+	}
+
+	s.w.order.WriteUint(s.w.tmp[:], n, v)
+
+	return s.w.flush(n)
+}
+
+// WriteInt writes n bytes, where n must be within [1, 8]. Returns an OverflowError if v does not fit into n bytes.
+// Panics if n is invalid.
+func (s *StrictStreamWriter) WriteInt(n int, v int64) error {
+	if err := intOverflow(v, minIntOf(n), maxIntOf(n), 8*n); err != nil { //nolint:gomnd
+		return err
+	}
+
+	s.w.order.WriteInt(s.w.tmp[:], n, v)
+
+	return s.w.flush(n)
+}
+
+// WriteUint8 writes a single byte. Returns an OverflowError if v > MaxUint8.
+func (s *StrictStreamWriter) WriteUint8(v uint64) error {
+	return s.WriteUint(1, v)
+}
+
+// WriteUint16 writes 2 bytes. Returns an OverflowError if v > MaxUint16.
+func (s *StrictStreamWriter) WriteUint16(v uint64) error {
+	return s.WriteUint(2, v) //nolint:gomnd
+}
+
+// WriteUint24 writes 3 bytes. Returns an OverflowError if v > MaxUint24.
+func (s *StrictStreamWriter) WriteUint24(v uint64) error {
+	return s.WriteUint(3, v) //nolint:gomnd
+}
+
+// WriteUint32 writes 4 bytes. Returns an OverflowError if v > MaxUint32.
+func (s *StrictStreamWriter) WriteUint32(v uint64) error {
+	return s.WriteUint(4, v) //nolint:gomnd
+}
+
+// WriteUint40 writes 5 bytes. Returns an OverflowError if v > MaxUint40.
+func (s *StrictStreamWriter) WriteUint40(v uint64) error {
+	return s.WriteUint(5, v) //nolint:gomnd
+}
+
+// WriteUint48 writes 6 bytes. Returns an OverflowError if v > MaxUint48.
+func (s *StrictStreamWriter) WriteUint48(v uint64) error {
+	return s.WriteUint(6, v) //nolint:gomnd
+}
+
+// WriteUint56 writes 7 bytes. Returns an OverflowError if v > MaxUint56.
+func (s *StrictStreamWriter) WriteUint56(v uint64) error {
+	return s.WriteUint(7, v) //nolint:gomnd
+}
+
+// WriteUint64 writes 8 bytes.
+func (s *StrictStreamWriter) WriteUint64(v uint64) error {
+	return s.WriteUint(8, v) //nolint:gomnd
+}
+
+// WriteInt8 writes a single byte. Returns an OverflowError if v is not within [MinInt8, MaxInt8].
+func (s *StrictStreamWriter) WriteInt8(v int64) error {
+	return s.WriteInt(1, v)
+}
+
+// WriteInt16 writes 2 bytes. Returns an OverflowError if v is not within [MinInt16, MaxInt16].
+func (s *StrictStreamWriter) WriteInt16(v int64) error {
+	return s.WriteInt(2, v) //nolint:gomnd
+}
+
+// WriteInt24 writes 3 bytes. Returns an OverflowError if v is not within [MinInt24, MaxInt24].
+func (s *StrictStreamWriter) WriteInt24(v int64) error {
+	return s.WriteInt(3, v) //nolint:gomnd
+}
+
+// WriteInt32 writes 4 bytes. Returns an OverflowError if v is not within [MinInt32, MaxInt32].
+func (s *StrictStreamWriter) WriteInt32(v int64) error {
+	return s.WriteInt(4, v) //nolint:gomnd
+}
+
+// WriteInt40 writes 5 bytes. Returns an OverflowError if v is not within [MinInt40, MaxInt40].
+func (s *StrictStreamWriter) WriteInt40(v int64) error {
+	return s.WriteInt(5, v) //nolint:gomnd
+}
+
+// WriteInt48 writes 6 bytes. Returns an OverflowError if v is not within [MinInt48, MaxInt48].
+func (s *StrictStreamWriter) WriteInt48(v int64) error {
+	return s.WriteInt(6, v) //nolint:gomnd
+}
+
+// WriteInt56 writes 7 bytes. Returns an OverflowError if v is not within [MinInt56, MaxInt56].
+func (s *StrictStreamWriter) WriteInt56(v int64) error {
+	return s.WriteInt(7, v) //nolint:gomnd
+}
+
+// WriteInt64 writes 8 bytes.
+func (s *StrictStreamWriter) WriteInt64(v int64) error {
+	return s.WriteInt(8, v) //nolint:gomnd
+}
+
+// WriteFloat32 writes 4 bytes.
+func (s *StrictStreamWriter) WriteFloat32(v float32) error {
+	return s.w.WriteFloat32(v)
+}
+
+// WriteFloat64 writes 8 bytes.
+func (s *StrictStreamWriter) WriteFloat64(v float64) error {
+	return s.w.WriteFloat64(v)
+}
+
+// WriteUvarint writes the unsigned LEB128 encoding of v.
+func (s *StrictStreamWriter) WriteUvarint(v uint64) error {
+	return s.w.WriteUvarint(v)
+}
+
+// WriteVarint writes the signed LEB128 encoding of v.
+func (s *StrictStreamWriter) WriteVarint(v int64) error {
+	return s.w.WriteVarint(v)
+}
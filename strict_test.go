@@ -0,0 +1,246 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestStrictBuffer(t *testing.T) {
+	tests := []struct {
+		buf *StrictBuffer
+		r   func([]byte) *Reader
+	}{
+		{NewLittleEndianStrictBuffer(nil), NewLittleEndianReader},
+		{NewBigEndianStrictBuffer(make([]byte, 0, 4)), NewBigEndianReader},
+	}
+
+	for _, tt := range tests {
+		b := tt.buf
+		b.Grow(82)
+
+		for _, err := range []error{
+			b.AppendUint16(1), b.AppendUint24(2), b.AppendUint32(3), b.AppendUint40(4), b.AppendUint48(5),
+			b.AppendUint56(6), b.AppendUint64(7), b.AppendInt16(-1), b.AppendInt24(-2), b.AppendInt32(-3),
+			b.AppendInt40(-4), b.AppendInt48(-5), b.AppendInt56(-6), b.AppendInt64(-7),
+		} {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		b.AppendFloat32(8)
+		b.AppendFloat64(9)
+
+		if b.Len() != 2*35+12 {
+			t.Fatalf("unexpected length %d", b.Len())
+		}
+
+		r := tt.r(b.Bytes())
+		if r.ReadUint16() != 1 || r.ReadUint24() != 2 || r.ReadUint32() != 3 || r.ReadUint40() != 4 ||
+			r.ReadUint48() != 5 || r.ReadUint56() != 6 || r.ReadUint64() != 7 {
+			t.Fatalf("unexpected unsigned values")
+		}
+
+		if r.ReadInt16() != -1 || r.ReadInt24() != -2 || r.ReadInt32() != -3 || r.ReadInt40() != -4 ||
+			r.ReadInt48() != -5 || r.ReadInt56() != -6 || r.ReadInt64() != -7 {
+			t.Fatalf("unexpected signed values")
+		}
+
+		if r.ReadFloat32() != 8 || r.ReadFloat64() != 9 {
+			t.Fatalf("unexpected float values")
+		}
+
+		b.Reset()
+
+		if b.Len() != 0 {
+			t.Fatalf("expected empty buffer")
+		}
+	}
+}
+
+func TestStrictBufferOverflow(t *testing.T) {
+	b := NewStrictBuffer(nil, Big)
+
+	for _, err := range []error{
+		b.AppendUint16(uint64(MaxUint16) + 1),
+		b.AppendUint24(uint64(MaxUint24) + 1),
+		b.AppendUint32(uint64(MaxUint32) + 1),
+		b.AppendUint40(MaxUint40 + 1),
+		b.AppendUint48(MaxUint48 + 1),
+		b.AppendUint56(MaxUint56 + 1),
+		b.AppendInt16(int64(MinInt16) - 1),
+		b.AppendInt24(int64(MaxInt24) + 1),
+		b.AppendInt32(int64(MinInt32) - 1),
+		b.AppendInt40(MaxInt40 + 1),
+		b.AppendInt48(MinInt48 - 1),
+		b.AppendInt56(MaxInt56 + 1),
+		b.AppendUint(1, 256),
+		b.AppendInt(1, -129),
+		b.AppendUint8(256),
+		b.AppendInt8(128),
+		b.AppendFixed(Fixed{Raw: 1 << 15, Format: Q15}),
+		b.AppendBCD(1, 100),
+		b.AppendBCDString(1, "100"),
+		b.AppendPacked(1, 10),
+		b.AppendPackedString(1, "10"),
+		b.AppendZoned(1, 10),
+		b.AppendZonedString(1, "10"),
+	} {
+		if !errors.Is(err, ErrOverflow) {
+			t.Fatalf("expected overflow but got %v", err)
+		}
+	}
+
+	if b.Len() != 0 || b.Order() != Big {
+		t.Fatalf("expected that nothing has been appended")
+	}
+
+	if err := b.AppendUint(1, 255); err != nil || !bytes.Equal(b.Bytes(), []byte{0xFF}) {
+		t.Fatalf("unexpected %X %v", b.Bytes(), err)
+	}
+
+	assertWidthPanics(t, 9, func() { _ = b.AppendUint(9, 0) })
+	assertWidthPanics(t, 0, func() { _ = b.AppendInt(0, 0) })
+}
+
+func TestStrictStreamWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	w := NewLittleEndianStrictStreamWriter(&buf)
+
+	for _, err := range []error{
+		w.WriteUint8(256),
+		w.WriteUint16(uint64(MaxUint16) + 1),
+		w.WriteUint24(uint64(MaxUint24) + 1),
+		w.WriteUint32(uint64(MaxUint32) + 1),
+		w.WriteUint40(MaxUint40 + 1),
+		w.WriteUint48(MaxUint48 + 1),
+		w.WriteUint56(MaxUint56 + 1),
+		w.WriteInt8(-129),
+		w.WriteInt16(int64(MaxInt16) + 1),
+		w.WriteInt24(int64(MinInt24) - 1),
+		w.WriteInt32(int64(MaxInt32) + 1),
+		w.WriteInt40(MinInt40 - 1),
+		w.WriteInt48(MaxInt48 + 1),
+		w.WriteInt56(MinInt56 - 1),
+	} {
+		if !errors.Is(err, ErrOverflow) {
+			t.Fatalf("expected overflow but got %v", err)
+		}
+	}
+
+	if buf.Len() != 0 {
+		t.Fatalf("expected that nothing has been written")
+	}
+
+	for _, err := range []error{
+		w.WriteUint8(255), w.WriteUint16(0xFFFF), w.WriteUint24(0xFFFFFF), w.WriteUint32(0xFFFFFFFF),
+		w.WriteUint40(MaxUint40), w.WriteUint48(MaxUint48), w.WriteUint56(MaxUint56), w.WriteUint64(MaxUint64),
+		w.WriteInt8(-1), w.WriteInt16(-1), w.WriteInt24(-1), w.WriteInt32(-1), w.WriteInt40(-1), w.WriteInt48(-1),
+		w.WriteInt56(-1), w.WriteInt64(-1),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !bytes.Equal(buf.Bytes(), bytes.Repeat([]byte{0xFF}, 2*(1+2+3+4+5+6+7+8))) {
+		t.Fatalf("unexpected bytes %X", buf.Bytes())
+	}
+
+	assertWidthPanics(t, 9, func() { _ = w.WriteUint(9, 0) })
+	assertWidthPanics(t, 0, func() { _ = w.WriteInt(0, 0) })
+}
+
+func TestStrictStreamWriterForward(t *testing.T) {
+	for _, order := range []Order{Little, Big} {
+		var buf bytes.Buffer
+
+		w := NewBigEndianStrictStreamWriter(&buf)
+		if order == Little {
+			w = NewStrictStreamWriter(&buf, order)
+		}
+
+		for _, err := range []error{
+			w.WriteUint24(0x123456), w.WriteInt40(MinInt40), w.WriteFloat32(1.5), w.WriteFloat64(-2),
+			w.WriteUvarint(300), w.WriteVarint(-300),
+		} {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		expected := NewBuffer(nil, order)
+		expected.AppendUint24(0x123456)
+		expected.AppendInt40(MinInt40)
+		expected.AppendFloat32(1.5)
+		expected.AppendFloat64(-2)
+		expected.AppendUvarint(300)
+		expected.AppendVarint(-300)
+
+		if !bytes.Equal(buf.Bytes(), expected.Bytes()) {
+			t.Fatalf("%v: expected %X but got %X", order, expected.Bytes(), buf.Bytes())
+		}
+	}
+}
+
+func TestStrictBufferForward(t *testing.T) {
+	b := NewLittleEndianStrictBuffer(nil)
+	expected := NewLittleEndianBuffer(nil)
+
+	for _, err := range []error{
+		b.AppendUint8(0xFF), b.AppendInt8(-128), b.AppendFixed(Fixed{Raw: -1, Format: Q15}),
+		b.AppendBCD(2, 1234), b.AppendBCDString(1, "56"), b.AppendPacked(2, -12), b.AppendPackedString(1, "3"),
+		b.AppendZoned(2, 45), b.AppendZonedString(1, "-6"),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b.AppendUvarint(300)
+	b.AppendVarint(-300)
+	b.AppendUint128(MaxUint128)
+	b.AppendInt128(MinInt128)
+
+	expected.AppendUint(1, 0xFF)
+	expected.AppendInt(1, -128)
+	expected.AppendFixed(Fixed{Raw: -1, Format: Q15})
+
+	for _, err := range []error{
+		expected.AppendBCD(2, 1234), expected.AppendBCDString(1, "56"), expected.AppendPacked(2, -12),
+		expected.AppendPackedString(1, "3"), expected.AppendZoned(2, 45), expected.AppendZonedString(1, "-6"),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected.AppendUvarint(300)
+	expected.AppendVarint(-300)
+	expected.AppendUint128(MaxUint128)
+	expected.AppendInt128(MinInt128)
+
+	if !bytes.Equal(b.Bytes(), expected.Bytes()) {
+		t.Fatalf("expected %X but got %X", expected.Bytes(), b.Bytes())
+	}
+}
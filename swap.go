@@ -0,0 +1,138 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// mustGroup panics, if the length of a buffer is not a multiple of the group size n.
+func mustGroup(length, n int) {
+	if length%n != 0 {
+		panic("byteorder: buffer length " + strconv.Itoa(length) + " is not a multiple of " + strconv.Itoa(n))
+	}
+}
+
+// Swap16 converts v between its little-endian and big-endian representation.
+func Swap16(v uint16) uint16 {
+	return bits.ReverseBytes16(v)
+}
+
+// Swap24 converts the lower 3 bytes of v between their little-endian and big-endian representation. The upper
+// byte is ignored and always zero in the result.
+func Swap24(v uint32) uint32 {
+	return bits.ReverseBytes32(v) >> 8 //nolint:gomnd
+}
+
+// Swap32 converts v between its little-endian and big-endian representation.
+func Swap32(v uint32) uint32 {
+	return bits.ReverseBytes32(v)
+}
+
+// Swap40 converts the lower 5 bytes of v between their little-endian and big-endian representation. The upper
+// bytes are ignored and always zero in the result.
+func Swap40(v uint64) uint64 {
+	return bits.ReverseBytes64(v) >> 24 //nolint:gomnd
+}
+
+// Swap48 converts the lower 6 bytes of v between their little-endian and big-endian representation. The upper
+// bytes are ignored and always zero in the result.
+func Swap48(v uint64) uint64 {
+	return bits.ReverseBytes64(v) >> 16 //nolint:gomnd
+}
+
+// Swap56 converts the lower 7 bytes of v between their little-endian and big-endian representation. The upper
+// bytes are ignored and always zero in the result.
+func Swap56(v uint64) uint64 {
+	return bits.ReverseBytes64(v) >> 8 //nolint:gomnd
+}
+
+// Swap64 converts v between its little-endian and big-endian representation.
+func Swap64(v uint64) uint64 {
+	return bits.ReverseBytes64(v)
+}
+
+// SwapBytes16 reverses the order of each 2 byte group in place, converting an array of 16 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 2.
+func SwapBytes16(buf []byte) {
+	mustGroup(len(buf), 2) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 2 {
+		BigEndian(buf[i:]).WriteUint16(LittleEndian(buf[i:]).ReadUint16())
+	}
+}
+
+// SwapBytes24 reverses the order of each 3 byte group in place, converting an array of 24 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 3.
+func SwapBytes24(buf []byte) {
+	mustGroup(len(buf), 3) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 3 {
+		BigEndian(buf[i:]).WriteUint24(LittleEndian(buf[i:]).ReadUint24())
+	}
+}
+
+// SwapBytes32 reverses the order of each 4 byte group in place, converting an array of 32 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 4.
+func SwapBytes32(buf []byte) {
+	mustGroup(len(buf), 4) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 4 {
+		BigEndian(buf[i:]).WriteUint32(LittleEndian(buf[i:]).ReadUint32())
+	}
+}
+
+// SwapBytes40 reverses the order of each 5 byte group in place, converting an array of 40 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 5.
+func SwapBytes40(buf []byte) {
+	mustGroup(len(buf), 5) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 5 {
+		BigEndian(buf[i:]).WriteUint40(LittleEndian(buf[i:]).ReadUint40())
+	}
+}
+
+// SwapBytes48 reverses the order of each 6 byte group in place, converting an array of 48 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 6.
+func SwapBytes48(buf []byte) {
+	mustGroup(len(buf), 6) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 6 {
+		BigEndian(buf[i:]).WriteUint48(LittleEndian(buf[i:]).ReadUint48())
+	}
+}
+
+// SwapBytes56 reverses the order of each 7 byte group in place, converting an array of 56 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 7.
+func SwapBytes56(buf []byte) {
+	mustGroup(len(buf), 7) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 7 {
+		BigEndian(buf[i:]).WriteUint56(LittleEndian(buf[i:]).ReadUint56())
+	}
+}
+
+// SwapBytes64 reverses the order of each 8 byte group in place, converting an array of 64 bit values between
+// little-endian and big-endian. Panics when len(buf) is not a multiple of 8.
+func SwapBytes64(buf []byte) {
+	mustGroup(len(buf), 8) //nolint:gomnd
+
+	for i := 0; i < len(buf); i += 8 {
+		BigEndian(buf[i:]).WriteUint64(LittleEndian(buf[i:]).ReadUint64())
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestSwap(t *testing.T) {
+	if Swap16(0x1122) != 0x2211 || Swap32(0x11223344) != 0x44332211 || Swap64(0x1122334455667788) != 0x8877665544332211 {
+		t.Fatalf("unexpected swap of standard widths")
+	}
+
+	if Swap24(0xFF112233) != 0x332211 || Swap40(0x1122334455) != 0x5544332211 ||
+		Swap48(0x112233445566) != 0x665544332211 || Swap56(0xFF11223344556677) != 0x77665544332211 {
+		t.Fatalf("unexpected swap of odd widths")
+	}
+}
+
+func TestSwapBytes(t *testing.T) {
+	tests := []struct {
+		size   int
+		swap   func([]byte)
+		scalar func(b []byte) (le, be uint64, swapped uint64)
+	}{
+		{2, SwapBytes16, func(b []byte) (uint64, uint64, uint64) {
+			return uint64(LE(b).ReadUint16()), uint64(BE(b).ReadUint16()), uint64(Swap16(LE(b).ReadUint16()))
+		}},
+		{3, SwapBytes24, func(b []byte) (uint64, uint64, uint64) {
+			return uint64(LE(b).ReadUint24()), uint64(BE(b).ReadUint24()), uint64(Swap24(LE(b).ReadUint24()))
+		}},
+		{4, SwapBytes32, func(b []byte) (uint64, uint64, uint64) {
+			return uint64(LE(b).ReadUint32()), uint64(BE(b).ReadUint32()), uint64(Swap32(LE(b).ReadUint32()))
+		}},
+		{5, SwapBytes40, func(b []byte) (uint64, uint64, uint64) {
+			return LE(b).ReadUint40(), BE(b).ReadUint40(), Swap40(LE(b).ReadUint40())
+		}},
+		{6, SwapBytes48, func(b []byte) (uint64, uint64, uint64) {
+			return LE(b).ReadUint48(), BE(b).ReadUint48(), Swap48(LE(b).ReadUint48())
+		}},
+		{7, SwapBytes56, func(b []byte) (uint64, uint64, uint64) {
+			return LE(b).ReadUint56(), BE(b).ReadUint56(), Swap56(LE(b).ReadUint56())
+		}},
+		{8, SwapBytes64, func(b []byte) (uint64, uint64, uint64) {
+			return LE(b).ReadUint64(), BE(b).ReadUint64(), Swap64(LE(b).ReadUint64())
+		}},
+	}
+
+	for _, tt := range tests {
+		data := bytes.Repeat(src, 3)[:3*tt.size]
+		tmp := append([]byte(nil), data...)
+		tt.swap(tmp)
+
+		for i := 0; i < len(data); i += tt.size {
+			le, be, swapped := tt.scalar(data[i:])
+			_, inPlace, _ := tt.scalar(tmp[i:])
+
+			if inPlace != le || swapped != be {
+				t.Fatalf("%d: expected %x and %x but got %x and %x", tt.size, le, be, inPlace, swapped)
+			}
+		}
+
+		assertSwapPanics(t, tt.size, tt.swap)
+	}
+}
+
+func assertSwapPanics(t *testing.T, size int, swap func([]byte)) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("%d: expected panic", size)
+		}
+	}()
+
+	swap(make([]byte, size+1))
+}
@@ -0,0 +1,698 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// shortBuffer returns a ShortBufferError, if available < needed.
+func shortBuffer(needed, available int) error {
+	if available < needed {
+		return &ShortBufferError{Needed: needed, Available: available}
+	}
+
+	return nil
+}
+
+// TryReadUint16 is like ReadUint16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b LittleEndian) TryReadUint16() (uint16, error) {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint16(), nil
+}
+
+// TryWriteUint16 is like WriteUint16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b LittleEndian) TryWriteUint16(v uint16) error {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint16(v)
+
+	return nil
+}
+
+// TryReadUint24 is like ReadUint24 but returns a ShortBufferError instead of panicking when len(b) < 3.
+func (b LittleEndian) TryReadUint24() (uint32, error) {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint24(), nil
+}
+
+// TryWriteUint24 is like WriteUint24 but returns a ShortBufferError instead of panicking when len(b) < 3.
+func (b LittleEndian) TryWriteUint24(v uint32) error {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint24(v)
+
+	return nil
+}
+
+// TryReadUint32 is like ReadUint32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b LittleEndian) TryReadUint32() (uint32, error) {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint32(), nil
+}
+
+// TryWriteUint32 is like WriteUint32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b LittleEndian) TryWriteUint32(v uint32) error {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint32(v)
+
+	return nil
+}
+
+// TryReadUint40 is like ReadUint40 but returns a ShortBufferError instead of panicking when len(b) < 5.
+func (b LittleEndian) TryReadUint40() (uint64, error) {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint40(), nil
+}
+
+// TryWriteUint40 is like WriteUint40 but returns a ShortBufferError instead of panicking when len(b) < 5.
+func (b LittleEndian) TryWriteUint40(v uint64) error {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint40(v)
+
+	return nil
+}
+
+// TryReadUint48 is like ReadUint48 but returns a ShortBufferError instead of panicking when len(b) < 6.
+func (b LittleEndian) TryReadUint48() (uint64, error) {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint48(), nil
+}
+
+// TryWriteUint48 is like WriteUint48 but returns a ShortBufferError instead of panicking when len(b) < 6.
+func (b LittleEndian) TryWriteUint48(v uint64) error {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint48(v)
+
+	return nil
+}
+
+// TryReadUint56 is like ReadUint56 but returns a ShortBufferError instead of panicking when len(b) < 7.
+func (b LittleEndian) TryReadUint56() (uint64, error) {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint56(), nil
+}
+
+// TryWriteUint56 is like WriteUint56 but returns a ShortBufferError instead of panicking when len(b) < 7.
+func (b LittleEndian) TryWriteUint56(v uint64) error {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint56(v)
+
+	return nil
+}
+
+// TryReadUint64 is like ReadUint64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b LittleEndian) TryReadUint64() (uint64, error) {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint64(), nil
+}
+
+// TryWriteUint64 is like WriteUint64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b LittleEndian) TryWriteUint64(v uint64) error {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint64(v)
+
+	return nil
+}
+
+// TryReadInt16 is like ReadInt16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b LittleEndian) TryReadInt16() (int16, error) {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt16(), nil
+}
+
+// TryWriteInt16 is like WriteInt16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b LittleEndian) TryWriteInt16(v int16) error {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt16(v)
+
+	return nil
+}
+
+// TryReadInt24 is like ReadInt24 but returns a ShortBufferError instead of panicking when len(b) < 3.
+func (b LittleEndian) TryReadInt24() (int32, error) {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt24(), nil
+}
+
+// TryWriteInt24 is like WriteInt24 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b LittleEndian) TryWriteInt24(v int32) error {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(int64(v), int64(MinInt24), int64(MaxInt24), 24); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt24(v)
+
+	return nil
+}
+
+// TryReadInt32 is like ReadInt32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b LittleEndian) TryReadInt32() (int32, error) {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt32(), nil
+}
+
+// TryWriteInt32 is like WriteInt32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b LittleEndian) TryWriteInt32(v int32) error {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt32(v)
+
+	return nil
+}
+
+// TryReadInt40 is like ReadInt40 but returns a ShortBufferError instead of panicking when len(b) < 5.
+func (b LittleEndian) TryReadInt40() (int64, error) {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt40(), nil
+}
+
+// TryWriteInt40 is like WriteInt40 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b LittleEndian) TryWriteInt40(v int64) error {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(v, MinInt40, MaxInt40, 40); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt40(v)
+
+	return nil
+}
+
+// TryReadInt48 is like ReadInt48 but returns a ShortBufferError instead of panicking when len(b) < 6.
+func (b LittleEndian) TryReadInt48() (int64, error) {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt48(), nil
+}
+
+// TryWriteInt48 is like WriteInt48 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b LittleEndian) TryWriteInt48(v int64) error {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(v, MinInt48, MaxInt48, 48); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt48(v)
+
+	return nil
+}
+
+// TryReadInt56 is like ReadInt56 but returns a ShortBufferError instead of panicking when len(b) < 7.
+func (b LittleEndian) TryReadInt56() (int64, error) {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt56(), nil
+}
+
+// TryWriteInt56 is like WriteInt56 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b LittleEndian) TryWriteInt56(v int64) error {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(v, MinInt56, MaxInt56, 56); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt56(v)
+
+	return nil
+}
+
+// TryReadInt64 is like ReadInt64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b LittleEndian) TryReadInt64() (int64, error) {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt64(), nil
+}
+
+// TryWriteInt64 is like WriteInt64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b LittleEndian) TryWriteInt64(v int64) error {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt64(v)
+
+	return nil
+}
+
+// TryReadFloat32 is like ReadFloat32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b LittleEndian) TryReadFloat32() (float32, error) {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadFloat32(), nil
+}
+
+// TryWriteFloat32 is like WriteFloat32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b LittleEndian) TryWriteFloat32(v float32) error {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteFloat32(v)
+
+	return nil
+}
+
+// TryReadFloat64 is like ReadFloat64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b LittleEndian) TryReadFloat64() (float64, error) {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadFloat64(), nil
+}
+
+// TryWriteFloat64 is like WriteFloat64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b LittleEndian) TryWriteFloat64(v float64) error {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteFloat64(v)
+
+	return nil
+}
+
+// TryReadUint16 is like ReadUint16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b BigEndian) TryReadUint16() (uint16, error) {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint16(), nil
+}
+
+// TryWriteUint16 is like WriteUint16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b BigEndian) TryWriteUint16(v uint16) error {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint16(v)
+
+	return nil
+}
+
+// TryReadUint24 is like ReadUint24 but returns a ShortBufferError instead of panicking when len(b) < 3.
+func (b BigEndian) TryReadUint24() (uint32, error) {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint24(), nil
+}
+
+// TryWriteUint24 is like WriteUint24 but returns a ShortBufferError instead of panicking when len(b) < 3.
+func (b BigEndian) TryWriteUint24(v uint32) error {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint24(v)
+
+	return nil
+}
+
+// TryReadUint32 is like ReadUint32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b BigEndian) TryReadUint32() (uint32, error) {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint32(), nil
+}
+
+// TryWriteUint32 is like WriteUint32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b BigEndian) TryWriteUint32(v uint32) error {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint32(v)
+
+	return nil
+}
+
+// TryReadUint40 is like ReadUint40 but returns a ShortBufferError instead of panicking when len(b) < 5.
+func (b BigEndian) TryReadUint40() (uint64, error) {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint40(), nil
+}
+
+// TryWriteUint40 is like WriteUint40 but returns a ShortBufferError instead of panicking when len(b) < 5.
+func (b BigEndian) TryWriteUint40(v uint64) error {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint40(v)
+
+	return nil
+}
+
+// TryReadUint48 is like ReadUint48 but returns a ShortBufferError instead of panicking when len(b) < 6.
+func (b BigEndian) TryReadUint48() (uint64, error) {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint48(), nil
+}
+
+// TryWriteUint48 is like WriteUint48 but returns a ShortBufferError instead of panicking when len(b) < 6.
+func (b BigEndian) TryWriteUint48(v uint64) error {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint48(v)
+
+	return nil
+}
+
+// TryReadUint56 is like ReadUint56 but returns a ShortBufferError instead of panicking when len(b) < 7.
+func (b BigEndian) TryReadUint56() (uint64, error) {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint56(), nil
+}
+
+// TryWriteUint56 is like WriteUint56 but returns a ShortBufferError instead of panicking when len(b) < 7.
+func (b BigEndian) TryWriteUint56(v uint64) error {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint56(v)
+
+	return nil
+}
+
+// TryReadUint64 is like ReadUint64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b BigEndian) TryReadUint64() (uint64, error) {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadUint64(), nil
+}
+
+// TryWriteUint64 is like WriteUint64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b BigEndian) TryWriteUint64(v uint64) error {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteUint64(v)
+
+	return nil
+}
+
+// TryReadInt16 is like ReadInt16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b BigEndian) TryReadInt16() (int16, error) {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt16(), nil
+}
+
+// TryWriteInt16 is like WriteInt16 but returns a ShortBufferError instead of panicking when len(b) < 2.
+func (b BigEndian) TryWriteInt16(v int16) error {
+	if err := shortBuffer(2, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt16(v)
+
+	return nil
+}
+
+// TryReadInt24 is like ReadInt24 but returns a ShortBufferError instead of panicking when len(b) < 3.
+func (b BigEndian) TryReadInt24() (int32, error) {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt24(), nil
+}
+
+// TryWriteInt24 is like WriteInt24 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b BigEndian) TryWriteInt24(v int32) error {
+	if err := shortBuffer(3, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(int64(v), int64(MinInt24), int64(MaxInt24), 24); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt24(v)
+
+	return nil
+}
+
+// TryReadInt32 is like ReadInt32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b BigEndian) TryReadInt32() (int32, error) {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt32(), nil
+}
+
+// TryWriteInt32 is like WriteInt32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b BigEndian) TryWriteInt32(v int32) error {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt32(v)
+
+	return nil
+}
+
+// TryReadInt40 is like ReadInt40 but returns a ShortBufferError instead of panicking when len(b) < 5.
+func (b BigEndian) TryReadInt40() (int64, error) {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt40(), nil
+}
+
+// TryWriteInt40 is like WriteInt40 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b BigEndian) TryWriteInt40(v int64) error {
+	if err := shortBuffer(5, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(v, MinInt40, MaxInt40, 40); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt40(v)
+
+	return nil
+}
+
+// TryReadInt48 is like ReadInt48 but returns a ShortBufferError instead of panicking when len(b) < 6.
+func (b BigEndian) TryReadInt48() (int64, error) {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt48(), nil
+}
+
+// TryWriteInt48 is like WriteInt48 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b BigEndian) TryWriteInt48(v int64) error {
+	if err := shortBuffer(6, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(v, MinInt48, MaxInt48, 48); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt48(v)
+
+	return nil
+}
+
+// TryReadInt56 is like ReadInt56 but returns a ShortBufferError instead of panicking when len(b) < 7.
+func (b BigEndian) TryReadInt56() (int64, error) {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt56(), nil
+}
+
+// TryWriteInt56 is like WriteInt56 but returns a ShortBufferError or an OverflowError instead of panicking.
+func (b BigEndian) TryWriteInt56(v int64) error {
+	if err := shortBuffer(7, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if err := intOverflow(v, MinInt56, MaxInt56, 56); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt56(v)
+
+	return nil
+}
+
+// TryReadInt64 is like ReadInt64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b BigEndian) TryReadInt64() (int64, error) {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadInt64(), nil
+}
+
+// TryWriteInt64 is like WriteInt64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b BigEndian) TryWriteInt64(v int64) error {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteInt64(v)
+
+	return nil
+}
+
+// TryReadFloat32 is like ReadFloat32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b BigEndian) TryReadFloat32() (float32, error) {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadFloat32(), nil
+}
+
+// TryWriteFloat32 is like WriteFloat32 but returns a ShortBufferError instead of panicking when len(b) < 4.
+func (b BigEndian) TryWriteFloat32(v float32) error {
+	if err := shortBuffer(4, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteFloat32(v)
+
+	return nil
+}
+
+// TryReadFloat64 is like ReadFloat64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b BigEndian) TryReadFloat64() (float64, error) {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return 0, err
+	}
+
+	return b.ReadFloat64(), nil
+}
+
+// TryWriteFloat64 is like WriteFloat64 but returns a ShortBufferError instead of panicking when len(b) < 8.
+func (b BigEndian) TryWriteFloat64(v float64) error {
+	if err := shortBuffer(8, len(b)); err != nil { //nolint:gomnd
+		return err
+	}
+
+	b.WriteFloat64(v)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestTry(t *testing.T) {
+	for _, tt := range []struct {
+		size int
+		le   func(dst, src LE) (read, write error)
+		be   func(dst, src BE) (read, write error)
+	}{
+		{2,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint16(); return err, dst.TryWriteUint16(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint16(); return err, dst.TryWriteUint16(v) }},
+		{3,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint24(); return err, dst.TryWriteUint24(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint24(); return err, dst.TryWriteUint24(v) }},
+		{4,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint32(); return err, dst.TryWriteUint32(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint32(); return err, dst.TryWriteUint32(v) }},
+		{5,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint40(); return err, dst.TryWriteUint40(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint40(); return err, dst.TryWriteUint40(v) }},
+		{6,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint48(); return err, dst.TryWriteUint48(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint48(); return err, dst.TryWriteUint48(v) }},
+		{7,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint56(); return err, dst.TryWriteUint56(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint56(); return err, dst.TryWriteUint56(v) }},
+		{8,
+			func(dst, src LE) (error, error) { v, err := src.TryReadUint64(); return err, dst.TryWriteUint64(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadUint64(); return err, dst.TryWriteUint64(v) }},
+		{2,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt16(); return err, dst.TryWriteInt16(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt16(); return err, dst.TryWriteInt16(v) }},
+		{3,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt24(); return err, dst.TryWriteInt24(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt24(); return err, dst.TryWriteInt24(v) }},
+		{4,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt32(); return err, dst.TryWriteInt32(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt32(); return err, dst.TryWriteInt32(v) }},
+		{5,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt40(); return err, dst.TryWriteInt40(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt40(); return err, dst.TryWriteInt40(v) }},
+		{6,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt48(); return err, dst.TryWriteInt48(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt48(); return err, dst.TryWriteInt48(v) }},
+		{7,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt56(); return err, dst.TryWriteInt56(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt56(); return err, dst.TryWriteInt56(v) }},
+		{8,
+			func(dst, src LE) (error, error) { v, err := src.TryReadInt64(); return err, dst.TryWriteInt64(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadInt64(); return err, dst.TryWriteInt64(v) }},
+		{4,
+			func(dst, src LE) (error, error) { v, err := src.TryReadFloat32(); return err, dst.TryWriteFloat32(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadFloat32(); return err, dst.TryWriteFloat32(v) }},
+		{8,
+			func(dst, src LE) (error, error) { v, err := src.TryReadFloat64(); return err, dst.TryWriteFloat64(v) },
+			func(dst, src BE) (error, error) { v, err := src.TryReadFloat64(); return err, dst.TryWriteFloat64(v) }},
+	} {
+		for _, copyValue := range []func(dst, src []byte) (error, error){
+			func(dst, src []byte) (error, error) { return tt.le(dst, src) },
+			func(dst, src []byte) (error, error) { return tt.be(dst, src) },
+		} {
+			tmp := make([]byte, tt.size)
+			if read, write := copyValue(tmp, src); read != nil || write != nil {
+				t.Fatalf("%d: unexpected errors %v %v", tt.size, read, write)
+			}
+
+			assertValues(t, tmp)
+
+			read, write := copyValue(tmp[1:], tmp[1:])
+			assertShortBuffer(t, read, tt.size)
+			assertShortBuffer(t, write, tt.size)
+		}
+	}
+}
+
+func assertShortBuffer(t *testing.T, err error, needed int) {
+	t.Helper()
+
+	var sbe *ShortBufferError
+	if !errors.As(err, &sbe) || !errors.Is(err, ErrShortBuffer) {
+		t.Fatalf("expected short buffer error but got %v", err)
+	}
+
+	if sbe.Needed != needed || sbe.Available != needed-1 {
+		t.Fatalf("unexpected lengths in %v", err)
+	}
+}
+
+func TestTryOverflow(t *testing.T) {
+	tmp := make([]byte, 8)
+
+	for _, err := range []error{
+		LE(tmp).TryWriteInt24(MaxInt24 + 1),
+		BE(tmp).TryWriteInt24(MinInt24 - 1),
+		LE(tmp).TryWriteInt40(MaxInt40 + 1),
+		BE(tmp).TryWriteInt40(MinInt40 - 1),
+		LE(tmp).TryWriteInt48(MaxInt48 + 1),
+		BE(tmp).TryWriteInt48(MinInt48 - 1),
+		LE(tmp).TryWriteInt56(MaxInt56 + 1),
+		BE(tmp).TryWriteInt56(MinInt56 - 1),
+	} {
+		if !errors.Is(err, ErrOverflow) {
+			t.Fatalf("expected overflow but got %v", err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+import "math/bits"
+
+// MaxVarintLen64 is the maximum length of a LEB128 encoded 64 bit value.
+const MaxVarintLen64 = 10
+
+// ReadUvarint decodes an unsigned LEB128 value (ULEB128) from the start of b and returns it together with the
+// amount of consumed bytes. A ShortBufferError is returned if b ends before the last byte of the value and
+// ErrVarintOverflow if the value does not fit into 64 bit.
+func ReadUvarint(b []byte) (uint64, int, error) {
+	var v uint64
+
+	for i, c := range b {
+		if i == MaxVarintLen64-1 && c > 1 {
+			return 0, 0, ErrVarintOverflow
+		}
+
+		v |= uint64(c&0x7f) << (7 * i) //nolint:gomnd
+
+		if c < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+
+	return 0, 0, &ShortBufferError{Needed: len(b) + 1, Available: len(b)}
+}
+
+// ReadVarint decodes a signed LEB128 value (SLEB128) from the start of b and returns it together with the
+// amount of consumed bytes. A ShortBufferError is returned if b ends before the last byte of the value and
+// ErrVarintOverflow if the value does not fit into 64 bit.
+func ReadVarint(b []byte) (int64, int, error) {
+	var v uint64
+
+	for i, c := range b {
+		// the last byte only contributes the sign bit, so all other bits must repeat it
+		if i == MaxVarintLen64-1 && c != 0 && c != 0x7f {
+			return 0, 0, ErrVarintOverflow
+		}
+
+		v |= uint64(c&0x7f) << (7 * i) //nolint:gomnd
+
+		if c < 0x80 {
+			if shift := 7 * (i + 1); shift < 64 && c&0x40 != 0 {
+				v |= ^uint64(0) << shift
+			}
+
+			return int64(v), i + 1, nil
+		}
+	}
+
+	return 0, 0, &ShortBufferError{Needed: len(b) + 1, Available: len(b)}
+}
+
+// AppendUvarint appends the unsigned LEB128 encoding of v to dst and returns the extended slice.
+func AppendUvarint(dst []byte, v uint64) []byte {
+	for v >= 0x80 {
+		dst = append(dst, byte(v)|0x80)
+		v >>= 7
+	}
+
+	return append(dst, byte(v))
+}
+
+// AppendVarint appends the signed LEB128 encoding of v to dst and returns the extended slice.
+func AppendVarint(dst []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(dst, c)
+		}
+
+		dst = append(dst, c|0x80)
+	}
+}
+
+// WriteUvarint writes the unsigned LEB128 encoding of v and returns the amount of written bytes. Panics when
+// len(b) < UvarintLen(v).
+func WriteUvarint(b []byte, v uint64) int {
+	n := UvarintLen(v)
+	mustFit(n, len(b))
+	AppendUvarint(b[:0], v)
+
+	return n
+}
+
+// WriteVarint writes the signed LEB128 encoding of v and returns the amount of written bytes. Panics when
+// len(b) < VarintLen(v).
+func WriteVarint(b []byte, v int64) int {
+	n := VarintLen(v)
+	mustFit(n, len(b))
+	AppendVarint(b[:0], v)
+
+	return n
+}
+
+// UvarintLen returns the amount of bytes of the unsigned LEB128 encoding of v.
+func UvarintLen(v uint64) int {
+	return (bits.Len64(v|1) + 6) / 7 //nolint:gomnd
+}
+
+// VarintLen returns the amount of bytes of the signed LEB128 encoding of v.
+func VarintLen(v int64) int {
+	if v < 0 {
+		v = ^v
+	}
+
+	// one additional bit is required for the sign
+	return (bits.Len64(uint64(v)) + 1 + 6) / 7 //nolint:gomnd
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestUvarint(t *testing.T) {
+	tests := []struct {
+		v   uint64
+		enc []byte
+	}{
+		{0, []byte{0x00}},
+		{2, []byte{0x02}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{129, []byte{0x81, 0x01}},
+		{12857, []byte{0xb9, 0x64}},
+		{math.MaxUint64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+
+	for _, tt := range tests {
+		if enc := AppendUvarint(nil, tt.v); !bytes.Equal(enc, tt.enc) || UvarintLen(tt.v) != len(tt.enc) {
+			t.Fatalf("%d: unexpected encoding %x", tt.v, enc)
+		}
+
+		tmp := make([]byte, MaxVarintLen64)
+		if n := WriteUvarint(tmp, tt.v); !bytes.Equal(tmp[:n], tt.enc) {
+			t.Fatalf("%d: unexpected encoding %x", tt.v, tmp[:n])
+		}
+
+		if v, n, err := ReadUvarint(append(tt.enc, 0xff)); err != nil || v != tt.v || n != len(tt.enc) {
+			t.Fatalf("%x: unexpected decoding %d, %d, %v", tt.enc, v, n, err)
+		}
+	}
+}
+
+func TestVarint(t *testing.T) {
+	tests := []struct {
+		v   int64
+		enc []byte
+	}{
+		{0, []byte{0x00}},
+		{2, []byte{0x02}},
+		{-2, []byte{0x7e}},
+		{63, []byte{0x3f}},
+		{-64, []byte{0x40}},
+		{64, []byte{0xc0, 0x00}},
+		{127, []byte{0xff, 0x00}},
+		{-127, []byte{0x81, 0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{-128, []byte{0x80, 0x7f}},
+		{-129, []byte{0xff, 0x7e}},
+		{math.MaxInt64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}},
+		{math.MinInt64, []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f}},
+	}
+
+	for _, tt := range tests {
+		if enc := AppendVarint(nil, tt.v); !bytes.Equal(enc, tt.enc) || VarintLen(tt.v) != len(tt.enc) {
+			t.Fatalf("%d: unexpected encoding %x", tt.v, enc)
+		}
+
+		tmp := make([]byte, MaxVarintLen64)
+		if n := WriteVarint(tmp, tt.v); !bytes.Equal(tmp[:n], tt.enc) {
+			t.Fatalf("%d: unexpected encoding %x", tt.v, tmp[:n])
+		}
+
+		if v, n, err := ReadVarint(append(tt.enc, 0xff)); err != nil || v != tt.v || n != len(tt.enc) {
+			t.Fatalf("%x: unexpected decoding %d, %d, %v", tt.enc, v, n, err)
+		}
+	}
+}
+
+func TestVarintInvalid(t *testing.T) {
+	tooLong := bytes.Repeat([]byte{0x80}, MaxVarintLen64+1)
+	uoverflow := append(bytes.Repeat([]byte{0xff}, MaxVarintLen64-1), 0x02)
+	soverflow := append(bytes.Repeat([]byte{0xff}, MaxVarintLen64-1), 0x01)
+
+	for _, b := range [][]byte{tooLong, uoverflow} {
+		if _, _, err := ReadUvarint(b); !errors.Is(err, ErrVarintOverflow) {
+			t.Fatalf("%x: expected overflow but got %v", b, err)
+		}
+	}
+
+	for _, b := range [][]byte{tooLong, soverflow} {
+		if _, _, err := ReadVarint(b); !errors.Is(err, ErrVarintOverflow) {
+			t.Fatalf("%x: expected overflow but got %v", b, err)
+		}
+	}
+
+	if _, _, err := ReadUvarint([]byte{0x80}); !errors.Is(err, ErrShortBuffer) {
+		t.Fatalf("expected short buffer but got %v", err)
+	}
+
+	if _, _, err := ReadVarint(nil); !errors.Is(err, ErrShortBuffer) {
+		t.Fatalf("expected short buffer but got %v", err)
+	}
+
+	assertBulkShort(t, func() { WriteUvarint(make([]byte, 1), 128) })
+	assertBulkShort(t, func() { WriteVarint(make([]byte, 1), 64) })
+}
+
+func TestVarintReaderBuffer(t *testing.T) {
+	buf := NewBigEndianBuffer(nil)
+	buf.AppendUint16(1)
+	buf.AppendUvarint(300)
+	buf.AppendVarint(-300)
+
+	r := NewBigEndianReader(buf.Bytes())
+	r.Skip(2)
+
+	if v, err := r.ReadUvarint(); err != nil || v != 300 {
+		t.Fatalf("unexpected uvarint %d: %v", v, err)
+	}
+
+	if v, err := r.ReadVarint(); err != nil || v != -300 || r.Remaining() != 0 {
+		t.Fatalf("unexpected varint %d: %v", v, err)
+	}
+
+	if _, err := r.ReadUvarint(); !errors.Is(err, ErrShortBuffer) || r.Remaining() != 0 {
+		t.Fatalf("expected short buffer but got %v", err)
+	}
+}
+
+func TestVarintStream(t *testing.T) {
+	var out bytes.Buffer
+
+	w := NewLittleEndianStreamWriter(&out)
+	if w.WriteUvarint(math.MaxUint64) != nil || w.WriteVarint(math.MinInt64) != nil {
+		t.Fatalf("unexpected write error")
+	}
+
+	r := NewLittleEndianStreamReader(bytes.NewReader(out.Bytes()))
+	if v, err := r.ReadUvarint(); err != nil || v != math.MaxUint64 {
+		t.Fatalf("unexpected uvarint %d: %v", v, err)
+	}
+
+	if v, err := r.ReadVarint(); err != nil || v != math.MinInt64 {
+		t.Fatalf("unexpected varint %d: %v", v, err)
+	}
+
+	if _, err := r.ReadVarint(); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF but got %v", err)
+	}
+
+	r = NewLittleEndianStreamReader(bytes.NewReader([]byte{0x80}))
+	if _, err := r.ReadUvarint(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected unexpected EOF but got %v", err)
+	}
+
+	r = NewLittleEndianStreamReader(bytes.NewReader(bytes.Repeat([]byte{0x80}, 11)))
+	if _, err := r.ReadUvarint(); !errors.Is(err, ErrVarintOverflow) {
+		t.Fatalf("expected overflow but got %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// ReadUint reads the first n bytes, where n must be within [1, 8], e.g. for formats which determine the width at
+// runtime. Panics when len(b) < n or if n is invalid.
+func (b LittleEndian) ReadUint(n int) uint64 {
+	switch n {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(b.ReadUint16())
+	case 3:
+		return uint64(b.ReadUint24())
+	case 4:
+		return uint64(b.ReadUint32())
+	case 5:
+		return b.ReadUint40()
+	case 6:
+		return b.ReadUint48()
+	case 7:
+		return b.ReadUint56()
+	case 8:
+		return b.ReadUint64()
+	default:
+		panic(invalidWidth(n))
+	}
+}
+
+// WriteUint writes the first n bytes, where n must be within [1, 8]. Panics when len(b) < n, if n is invalid or
+// if v does not fit into n bytes.
+func (b LittleEndian) WriteUint(n int, v uint64) {
+	checkUint(v, maxUintOf(n), 8*n) //nolint:gomnd
+
+	switch n {
+	case 1:
+		b[0] = byte(v)
+	case 2:
+		b.WriteUint16(uint16(v))
+	case 3:
+		b.WriteUint24(uint32(v))
+	case 4:
+		b.WriteUint32(uint32(v))
+	case 5:
+		b.WriteUint40(v)
+	case 6:
+		b.WriteUint48(v)
+	case 7:
+		b.WriteUint56(v)
+	case 8:
+		b.WriteUint64(v)
+	}
+}
+
+// ReadInt reads the first n bytes and sign extends the two's complement value, where n must be within [1, 8].
+// Panics when len(b) < n or if n is invalid.
+func (b LittleEndian) ReadInt(n int) int64 {
+	return signExtend(b.ReadUint(n), uint(8*n)) //nolint:gomnd
+}
+
+// WriteInt writes the first n bytes, where n must be within [1, 8]. Panics when len(b) < n, if n is invalid or
+// if v does not fit into n bytes.
+func (b LittleEndian) WriteInt(n int, v int64) {
+	checkInt(v, minIntOf(n), maxIntOf(n), 8*n) //nolint:gomnd
+	b.WriteUint(n, uint64(v)&maxUintOf(n))
+}
+
+// ReadUint reads the first n bytes, where n must be within [1, 8], e.g. for formats which determine the width at
+// runtime. Panics when len(b) < n or if n is invalid.
+func (b BigEndian) ReadUint(n int) uint64 {
+	switch n {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(b.ReadUint16())
+	case 3:
+		return uint64(b.ReadUint24())
+	case 4:
+		return uint64(b.ReadUint32())
+	case 5:
+		return b.ReadUint40()
+	case 6:
+		return b.ReadUint48()
+	case 7:
+		return b.ReadUint56()
+	case 8:
+		return b.ReadUint64()
+	default:
+		panic(invalidWidth(n))
+	}
+}
+
+// WriteUint writes the first n bytes, where n must be within [1, 8]. Panics when len(b) < n, if n is invalid or
+// if v does not fit into n bytes.
+func (b BigEndian) WriteUint(n int, v uint64) {
+	checkUint(v, maxUintOf(n), 8*n) //nolint:gomnd
+
+	switch n {
+	case 1:
+		b[0] = byte(v)
+	case 2:
+		b.WriteUint16(uint16(v))
+	case 3:
+		b.WriteUint24(uint32(v))
+	case 4:
+		b.WriteUint32(uint32(v))
+	case 5:
+		b.WriteUint40(v)
+	case 6:
+		b.WriteUint48(v)
+	case 7:
+		b.WriteUint56(v)
+	case 8:
+		b.WriteUint64(v)
+	}
+}
+
+// ReadInt reads the first n bytes and sign extends the two's complement value, where n must be within [1, 8].
+// Panics when len(b) < n or if n is invalid.
+func (b BigEndian) ReadInt(n int) int64 {
+	return signExtend(b.ReadUint(n), uint(8*n)) //nolint:gomnd
+}
+
+// WriteInt writes the first n bytes, where n must be within [1, 8]. Panics when len(b) < n, if n is invalid or
+// if v does not fit into n bytes.
+func (b BigEndian) WriteInt(n int, v int64) {
+	checkInt(v, minIntOf(n), maxIntOf(n), 8*n) //nolint:gomnd
+	b.WriteUint(n, uint64(v)&maxUintOf(n))
+}
+
+// ReadUint reads the first n bytes of b, where n must be within [1, 8]. Panics when len(b) < n or if n is
+// invalid.
+func (o Order) ReadUint(b []byte, n int) uint64 {
+	if o == Big {
+		return BigEndian(b).ReadUint(n)
+	}
+
+	return LittleEndian(b).ReadUint(n)
+}
+
+// WriteUint writes the first n bytes of b, where n must be within [1, 8]. Panics when len(b) < n, if n is
+// invalid or if v does not fit into n bytes.
+func (o Order) WriteUint(b []byte, n int, v uint64) {
+	if o == Big {
+		BigEndian(b).WriteUint(n, v)
+
+		return
+	}
+
+	LittleEndian(b).WriteUint(n, v)
+}
+
+// ReadUint reads the next n bytes and advances the position, where n must be within [1, 8]. Panics when
+// Remaining() < n or if n is invalid.
+func (r *Reader) ReadUint(n int) uint64 {
+	mustWidth(n)
+
+	return r.order.ReadUint(r.Next(n), n)
+}
+
+// AppendUint appends n bytes, where n must be within [1, 8]. Panics if n is invalid or if v does not fit into
+// n bytes.
+func (b *Buffer) AppendUint(n int, v uint64) {
+	var tmp [8]byte
+
+	b.order.WriteUint(tmp[:], n, v)
+	b.buf = append(b.buf, tmp[:n]...)
+}
+
+// ReadInt reads the first n bytes of b, where n must be within [1, 8]. Panics when len(b) < n or if n is
+// invalid.
+func (o Order) ReadInt(b []byte, n int) int64 {
+	if o == Big {
+		return BigEndian(b).ReadInt(n)
+	}
+
+	return LittleEndian(b).ReadInt(n)
+}
+
+// WriteInt writes the first n bytes of b, where n must be within [1, 8]. Panics when len(b) < n, if n is
+// invalid or if v does not fit into n bytes.
+func (o Order) WriteInt(b []byte, n int, v int64) {
+	if o == Big {
+		BigEndian(b).WriteInt(n, v)
+
+		return
+	}
+
+	LittleEndian(b).WriteInt(n, v)
+}
+
+// ReadInt reads the next n bytes and advances the position, where n must be within [1, 8]. Panics when
+// Remaining() < n or if n is invalid.
+func (r *Reader) ReadInt(n int) int64 {
+	mustWidth(n)
+
+	return r.order.ReadInt(r.Next(n), n)
+}
+
+// AppendInt appends n bytes, where n must be within [1, 8]. Panics if n is invalid or if v does not fit into
+// n bytes.
+func (b *Buffer) AppendInt(n int, v int64) {
+	var tmp [8]byte
+
+	b.order.WriteInt(tmp[:], n, v)
+	b.buf = append(b.buf, tmp[:n]...)
+}
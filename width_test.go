@@ -0,0 +1,144 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func TestWidth(t *testing.T) {
+	for n := 1; n <= 8; n++ {
+		var le, be uint64
+
+		for i := 0; i < n; i++ {
+			le |= uint64(src[i]) << (8 * i)
+			be = be<<8 | uint64(src[i])
+		}
+
+		tmp := make([]byte, n)
+
+		if v := LE(src).ReadUint(n); v != le {
+			t.Fatalf("%d: expected %x but got %x", n, le, v)
+		}
+
+		LE(tmp).WriteUint(n, le)
+		assertValues(t, tmp)
+
+		if v := BE(src).ReadUint(n); v != be {
+			t.Fatalf("%d: expected %x but got %x", n, be, v)
+		}
+
+		BE(tmp).WriteUint(n, be)
+		assertValues(t, tmp)
+
+		LE(tmp).WriteInt(n, LE(src).ReadInt(n))
+		assertValues(t, tmp)
+
+		BE(tmp).WriteInt(n, BE(src).ReadInt(n))
+		assertValues(t, tmp)
+	}
+}
+
+func TestWidthSignExtension(t *testing.T) {
+	for n := 1; n <= 8; n++ {
+		tmp := make([]byte, n)
+		min := int64(-1) << (8*n - 1)
+
+		for _, v := range []int64{-1, min, ^min} {
+			LE(tmp).WriteInt(n, v)
+
+			if r := LE(tmp).ReadInt(n); r != v {
+				t.Fatalf("%d: expected %d but got %d", n, v, r)
+			}
+
+			BE(tmp).WriteInt(n, v)
+
+			if r := BE(tmp).ReadInt(n); r != v {
+				t.Fatalf("%d: expected %d but got %d", n, v, r)
+			}
+		}
+
+		if n == 8 {
+			continue
+		}
+
+		assertOverflow(t, func() { LE(tmp).WriteUint(n, 1<<(8*n)) })
+		assertOverflow(t, func() { BE(tmp).WriteUint(n, 1<<(8*n)) })
+		assertOverflow(t, func() { LE(tmp).WriteInt(n, min-1) })
+		assertOverflow(t, func() { BE(tmp).WriteInt(n, ^min+1) })
+	}
+}
+
+func TestWidthInvalid(t *testing.T) {
+	tmp := make([]byte, 16)
+
+	for _, n := range []int{-1, 0, 9} {
+		n := n
+
+		for _, f := range []func(){
+			func() { LE(tmp).ReadUint(n) },
+			func() { BE(tmp).ReadInt(n) },
+			func() { LE(tmp).WriteUint(n, 0) },
+			func() { BE(tmp).WriteInt(n, 0) },
+			func() { NewLittleEndianReader(tmp).ReadUint(n) },
+			func() { NewBigEndianBuffer(nil).AppendInt(n, 0) },
+		} {
+			assertWidthPanics(t, n, f)
+		}
+	}
+}
+
+func assertWidthPanics(t *testing.T, n int, f func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("%d: expected panic", n)
+		}
+	}()
+
+	f()
+}
+
+func TestWidthReaderBuffer(t *testing.T) {
+	for _, order := range []Order{Little, Big} {
+		buf := NewBuffer(nil, order)
+
+		for n := 1; n <= 8; n++ {
+			buf.AppendUint(n, order.ReadUint(src, n))
+			buf.AppendInt(n, order.ReadInt(src, n))
+		}
+
+		r := NewReader(buf.Bytes(), order)
+
+		for n := 1; n <= 8; n++ {
+			tmp := make([]byte, n)
+
+			order.WriteUint(tmp, n, r.ReadUint(n))
+			assertValues(t, tmp)
+
+			order.WriteInt(tmp, n, r.ReadInt(n))
+			assertValues(t, tmp)
+		}
+
+		if r.Remaining() != 0 {
+			t.Fatalf("%v: unexpected remaining %d", order, r.Remaining())
+		}
+	}
+}
@@ -0,0 +1,258 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder
+
+// ZigZagEncode16 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4.
+func ZigZagEncode16(v int16) uint16 {
+	return uint16((v << 1) ^ (v >> 15)) //nolint:gomnd
+}
+
+// ZigZagDecode16 is the inverse of ZigZagEncode16.
+func ZigZagDecode16(v uint16) int16 {
+	return int16(v>>1) ^ -int16(v&1)
+}
+
+// ZigZagEncode24 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4. Panics if v is not within [MinInt24, MaxInt24].
+func ZigZagEncode24(v int32) uint32 {
+	checkInt(int64(v), int64(MinInt24), int64(MaxInt24), 24) //nolint:gomnd
+
+	return uint32((v << 1) ^ (v >> 31)) //nolint:gomnd
+}
+
+// ZigZagDecode24 is the inverse of ZigZagEncode24. Panics if v > MaxUint24.
+func ZigZagDecode24(v uint32) int32 {
+	checkUint(uint64(v), uint64(MaxUint24), 24) //nolint:gomnd
+
+	return int32(v>>1) ^ -int32(v&1)
+}
+
+// ZigZagEncode32 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4.
+func ZigZagEncode32(v int32) uint32 {
+	return uint32((v << 1) ^ (v >> 31)) //nolint:gomnd
+}
+
+// ZigZagDecode32 is the inverse of ZigZagEncode32.
+func ZigZagDecode32(v uint32) int32 {
+	return int32(v>>1) ^ -int32(v&1)
+}
+
+// ZigZagEncode40 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4. Panics if v is not within [MinInt40, MaxInt40].
+func ZigZagEncode40(v int64) uint64 {
+	checkInt(v, MinInt40, MaxInt40, 40) //nolint:gomnd
+
+	return uint64((v << 1) ^ (v >> 63)) //nolint:gomnd
+}
+
+// ZigZagDecode40 is the inverse of ZigZagEncode40. Panics if v > MaxUint40.
+func ZigZagDecode40(v uint64) int64 {
+	checkUint(uint64(v), uint64(MaxUint40), 40) //nolint:gomnd
+
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// ZigZagEncode48 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4. Panics if v is not within [MinInt48, MaxInt48].
+func ZigZagEncode48(v int64) uint64 {
+	checkInt(v, MinInt48, MaxInt48, 48) //nolint:gomnd
+
+	return uint64((v << 1) ^ (v >> 63)) //nolint:gomnd
+}
+
+// ZigZagDecode48 is the inverse of ZigZagEncode48. Panics if v > MaxUint48.
+func ZigZagDecode48(v uint64) int64 {
+	checkUint(uint64(v), uint64(MaxUint48), 48) //nolint:gomnd
+
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// ZigZagEncode56 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4. Panics if v is not within [MinInt56, MaxInt56].
+func ZigZagEncode56(v int64) uint64 {
+	checkInt(v, MinInt56, MaxInt56, 56) //nolint:gomnd
+
+	return uint64((v << 1) ^ (v >> 63)) //nolint:gomnd
+}
+
+// ZigZagDecode56 is the inverse of ZigZagEncode56. Panics if v > MaxUint56.
+func ZigZagDecode56(v uint64) int64 {
+	checkUint(uint64(v), uint64(MaxUint56), 56) //nolint:gomnd
+
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// ZigZagEncode64 maps the signed v to an unsigned value, so that small magnitudes result in small values:
+// 0, -1, 1, -2, 2 become 0, 1, 2, 3, 4.
+func ZigZagEncode64(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63)) //nolint:gomnd
+}
+
+// ZigZagDecode64 is the inverse of ZigZagEncode64.
+func ZigZagDecode64(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// ReadZigZag16 reads the first 2 bytes and decodes them using ZigZagDecode16. Panics when len(b) < 2.
+func (b LittleEndian) ReadZigZag16() int16 {
+	return ZigZagDecode16(b.ReadUint16())
+}
+
+// WriteZigZag16 encodes v using ZigZagEncode16 and writes the first 2 bytes. Panics when len(b) < 2.
+func (b LittleEndian) WriteZigZag16(v int16) {
+	b.WriteUint16(ZigZagEncode16(v))
+}
+
+// ReadZigZag24 reads the first 3 bytes and decodes them using ZigZagDecode24. Panics when len(b) < 3.
+func (b LittleEndian) ReadZigZag24() int32 {
+	return ZigZagDecode24(b.ReadUint24())
+}
+
+// WriteZigZag24 encodes v using ZigZagEncode24 and writes the first 3 bytes. Panics when len(b) < 3 or if v is
+// not within [MinInt24, MaxInt24].
+func (b LittleEndian) WriteZigZag24(v int32) {
+	b.WriteUint24(ZigZagEncode24(v))
+}
+
+// ReadZigZag32 reads the first 4 bytes and decodes them using ZigZagDecode32. Panics when len(b) < 4.
+func (b LittleEndian) ReadZigZag32() int32 {
+	return ZigZagDecode32(b.ReadUint32())
+}
+
+// WriteZigZag32 encodes v using ZigZagEncode32 and writes the first 4 bytes. Panics when len(b) < 4.
+func (b LittleEndian) WriteZigZag32(v int32) {
+	b.WriteUint32(ZigZagEncode32(v))
+}
+
+// ReadZigZag40 reads the first 5 bytes and decodes them using ZigZagDecode40. Panics when len(b) < 5.
+func (b LittleEndian) ReadZigZag40() int64 {
+	return ZigZagDecode40(b.ReadUint40())
+}
+
+// WriteZigZag40 encodes v using ZigZagEncode40 and writes the first 5 bytes. Panics when len(b) < 5 or if v is
+// not within [MinInt40, MaxInt40].
+func (b LittleEndian) WriteZigZag40(v int64) {
+	b.WriteUint40(ZigZagEncode40(v))
+}
+
+// ReadZigZag48 reads the first 6 bytes and decodes them using ZigZagDecode48. Panics when len(b) < 6.
+func (b LittleEndian) ReadZigZag48() int64 {
+	return ZigZagDecode48(b.ReadUint48())
+}
+
+// WriteZigZag48 encodes v using ZigZagEncode48 and writes the first 6 bytes. Panics when len(b) < 6 or if v is
+// not within [MinInt48, MaxInt48].
+func (b LittleEndian) WriteZigZag48(v int64) {
+	b.WriteUint48(ZigZagEncode48(v))
+}
+
+// ReadZigZag56 reads the first 7 bytes and decodes them using ZigZagDecode56. Panics when len(b) < 7.
+func (b LittleEndian) ReadZigZag56() int64 {
+	return ZigZagDecode56(b.ReadUint56())
+}
+
+// WriteZigZag56 encodes v using ZigZagEncode56 and writes the first 7 bytes. Panics when len(b) < 7 or if v is
+// not within [MinInt56, MaxInt56].
+func (b LittleEndian) WriteZigZag56(v int64) {
+	b.WriteUint56(ZigZagEncode56(v))
+}
+
+// ReadZigZag64 reads the first 8 bytes and decodes them using ZigZagDecode64. Panics when len(b) < 8.
+func (b LittleEndian) ReadZigZag64() int64 {
+	return ZigZagDecode64(b.ReadUint64())
+}
+
+// WriteZigZag64 encodes v using ZigZagEncode64 and writes the first 8 bytes. Panics when len(b) < 8.
+func (b LittleEndian) WriteZigZag64(v int64) {
+	b.WriteUint64(ZigZagEncode64(v))
+}
+
+// ReadZigZag16 reads the first 2 bytes and decodes them using ZigZagDecode16. Panics when len(b) < 2.
+func (b BigEndian) ReadZigZag16() int16 {
+	return ZigZagDecode16(b.ReadUint16())
+}
+
+// WriteZigZag16 encodes v using ZigZagEncode16 and writes the first 2 bytes. Panics when len(b) < 2.
+func (b BigEndian) WriteZigZag16(v int16) {
+	b.WriteUint16(ZigZagEncode16(v))
+}
+
+// ReadZigZag24 reads the first 3 bytes and decodes them using ZigZagDecode24. Panics when len(b) < 3.
+func (b BigEndian) ReadZigZag24() int32 {
+	return ZigZagDecode24(b.ReadUint24())
+}
+
+// WriteZigZag24 encodes v using ZigZagEncode24 and writes the first 3 bytes. Panics when len(b) < 3 or if v is
+// not within [MinInt24, MaxInt24].
+func (b BigEndian) WriteZigZag24(v int32) {
+	b.WriteUint24(ZigZagEncode24(v))
+}
+
+// ReadZigZag32 reads the first 4 bytes and decodes them using ZigZagDecode32. Panics when len(b) < 4.
+func (b BigEndian) ReadZigZag32() int32 {
+	return ZigZagDecode32(b.ReadUint32())
+}
+
+// WriteZigZag32 encodes v using ZigZagEncode32 and writes the first 4 bytes. Panics when len(b) < 4.
+func (b BigEndian) WriteZigZag32(v int32) {
+	b.WriteUint32(ZigZagEncode32(v))
+}
+
+// ReadZigZag40 reads the first 5 bytes and decodes them using ZigZagDecode40. Panics when len(b) < 5.
+func (b BigEndian) ReadZigZag40() int64 {
+	return ZigZagDecode40(b.ReadUint40())
+}
+
+// WriteZigZag40 encodes v using ZigZagEncode40 and writes the first 5 bytes. Panics when len(b) < 5 or if v is
+// not within [MinInt40, MaxInt40].
+func (b BigEndian) WriteZigZag40(v int64) {
+	b.WriteUint40(ZigZagEncode40(v))
+}
+
+// ReadZigZag48 reads the first 6 bytes and decodes them using ZigZagDecode48. Panics when len(b) < 6.
+func (b BigEndian) ReadZigZag48() int64 {
+	return ZigZagDecode48(b.ReadUint48())
+}
+
+// WriteZigZag48 encodes v using ZigZagEncode48 and writes the first 6 bytes. Panics when len(b) < 6 or if v is
+// not within [MinInt48, MaxInt48].
+func (b BigEndian) WriteZigZag48(v int64) {
+	b.WriteUint48(ZigZagEncode48(v))
+}
+
+// ReadZigZag56 reads the first 7 bytes and decodes them using ZigZagDecode56. Panics when len(b) < 7.
+func (b BigEndian) ReadZigZag56() int64 {
+	return ZigZagDecode56(b.ReadUint56())
+}
+
+// WriteZigZag56 encodes v using ZigZagEncode56 and writes the first 7 bytes. Panics when len(b) < 7 or if v is
+// not within [MinInt56, MaxInt56].
+func (b BigEndian) WriteZigZag56(v int64) {
+	b.WriteUint56(ZigZagEncode56(v))
+}
+
+// ReadZigZag64 reads the first 8 bytes and decodes them using ZigZagDecode64. Panics when len(b) < 8.
+func (b BigEndian) ReadZigZag64() int64 {
+	return ZigZagDecode64(b.ReadUint64())
+}
+
+// WriteZigZag64 encodes v using ZigZagEncode64 and writes the first 8 bytes. Panics when len(b) < 8.
+func (b BigEndian) WriteZigZag64(v int64) {
+	b.WriteUint64(ZigZagEncode64(v))
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2020 Torben Schinke
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package byteorder_test
+
+import (
+	"testing"
+
+	. "github.com/worldiety/byteorder"
+)
+
+func assertZigZag(t *testing.T, bits int, min, max int64, encode func(int64) uint64, decode func(uint64) int64) {
+	t.Helper()
+
+	maxUint := uint64(1)<<bits - 1
+	tests := map[int64]uint64{0: 0, -1: 1, 1: 2, -2: 3, 2: 4, max: maxUint - 1, min: maxUint}
+
+	for v, expected := range tests {
+		if enc := encode(v); enc != expected {
+			t.Fatalf("%d: expected %d for %d but got %d", bits, expected, v, enc)
+		}
+
+		if dec := decode(expected); dec != v {
+			t.Fatalf("%d: expected %d for %d but got %d", bits, v, expected, dec)
+		}
+	}
+}
+
+func TestZigZag(t *testing.T) {
+	assertZigZag(t, 16, int64(MinInt16), int64(MaxInt16),
+		func(v int64) uint64 { return uint64(ZigZagEncode16(int16(v))) },
+		func(v uint64) int64 { return int64(ZigZagDecode16(uint16(v))) })
+	assertZigZag(t, 24, int64(MinInt24), int64(MaxInt24),
+		func(v int64) uint64 { return uint64(ZigZagEncode24(int32(v))) },
+		func(v uint64) int64 { return int64(ZigZagDecode24(uint32(v))) })
+	assertZigZag(t, 32, int64(MinInt32), int64(MaxInt32),
+		func(v int64) uint64 { return uint64(ZigZagEncode32(int32(v))) },
+		func(v uint64) int64 { return int64(ZigZagDecode32(uint32(v))) })
+	assertZigZag(t, 40, MinInt40, MaxInt40, ZigZagEncode40, ZigZagDecode40)
+	assertZigZag(t, 48, MinInt48, MaxInt48, ZigZagEncode48, ZigZagDecode48)
+	assertZigZag(t, 56, MinInt56, MaxInt56, ZigZagEncode56, ZigZagDecode56)
+	assertZigZag(t, 64, MinInt64, MaxInt64, ZigZagEncode64, ZigZagDecode64)
+}
+
+func TestZigZagOverflow(t *testing.T) {
+	assertOverflow(t, func() { ZigZagEncode24(MaxInt24 + 1) })
+	assertOverflow(t, func() { ZigZagDecode24(MaxUint24 + 1) })
+	assertOverflow(t, func() { ZigZagEncode40(MinInt40 - 1) })
+	assertOverflow(t, func() { ZigZagDecode40(MaxUint40 + 1) })
+	assertOverflow(t, func() { ZigZagEncode48(MinInt48 - 1) })
+	assertOverflow(t, func() { ZigZagDecode48(MaxUint48 + 1) })
+	assertOverflow(t, func() { ZigZagEncode56(MinInt56 - 1) })
+	assertOverflow(t, func() { ZigZagDecode56(MaxUint56 + 1) })
+}
+
+func TestZigZagByteOrder(t *testing.T) {
+	tmp := make([]byte, 8)
+
+	LE(tmp).WriteZigZag16(-2)
+	BE(tmp[2:]).WriteZigZag16(-3)
+
+	if tmp[0] != 3 || tmp[3] != 5 || LE(tmp).ReadZigZag16() != -2 || BE(tmp[2:]).ReadZigZag16() != -3 {
+		t.Fatalf("unexpected 16 bit zigzag encoding %x", tmp)
+	}
+
+	LE(tmp).WriteZigZag24(MinInt24)
+	BE(tmp[3:]).WriteZigZag24(MaxInt24)
+
+	if LE(tmp).ReadZigZag24() != MinInt24 || BE(tmp[3:]).ReadZigZag24() != MaxInt24 {
+		t.Fatalf("unexpected 24 bit zigzag encoding %x", tmp)
+	}
+
+	LE(tmp).WriteZigZag32(MinInt32)
+	BE(tmp[4:]).WriteZigZag32(MaxInt32)
+
+	if LE(tmp).ReadZigZag32() != MinInt32 || BE(tmp[4:]).ReadZigZag32() != MaxInt32 {
+		t.Fatalf("unexpected 32 bit zigzag encoding %x", tmp)
+	}
+
+	for _, v := range []int64{-1, 1, MinInt40, MaxInt40} {
+		for _, o := range []interface {
+			ReadZigZag40() int64
+			WriteZigZag40(int64)
+			ReadZigZag48() int64
+			WriteZigZag48(int64)
+			ReadZigZag56() int64
+			WriteZigZag56(int64)
+			ReadZigZag64() int64
+			WriteZigZag64(int64)
+		}{LE(tmp), BE(tmp)} {
+			o.WriteZigZag40(v)
+			if o.ReadZigZag40() != v {
+				t.Fatalf("unexpected 40 bit zigzag encoding of %d", v)
+			}
+
+			o.WriteZigZag48(v)
+			if o.ReadZigZag48() != v {
+				t.Fatalf("unexpected 48 bit zigzag encoding of %d", v)
+			}
+
+			o.WriteZigZag56(v)
+			if o.ReadZigZag56() != v {
+				t.Fatalf("unexpected 56 bit zigzag encoding of %d", v)
+			}
+
+			o.WriteZigZag64(v)
+			if o.ReadZigZag64() != v {
+				t.Fatalf("unexpected 64 bit zigzag encoding of %d", v)
+			}
+		}
+	}
+}